@@ -0,0 +1,293 @@
+// Package astro computes sun/moon position for a temporal+spatial event -
+// the kind of (time.Time, neo4j.Point3D) pair Example 8's Shard building
+// and the Duration examples produce - using the NOAA low-precision solar
+// position algorithm and a synodic-month moon phase approximation. It's
+// self-contained: no external ephemeris library or network call.
+package astro
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// SunPosition is the sun's position in the local horizontal coordinate
+// system at a given time and location.
+type SunPosition struct {
+	AltitudeDeg float64
+	AzimuthDeg  float64
+}
+
+// IsDaylight reports whether the sun is above the horizon.
+func (p SunPosition) IsDaylight() bool {
+	return p.AltitudeDeg > 0
+}
+
+// MoonPosition is the moon's synodic phase angle (0=new, 90=first quarter,
+// 180=full, 270=last quarter) and the fraction of its disc illuminated.
+type MoonPosition struct {
+	PhaseDeg     float64
+	Illumination float64
+}
+
+type sunCacheKey struct {
+	hour   int64
+	latDeg int
+	lonDeg int
+}
+
+var (
+	sunCacheMu sync.Mutex
+	sunCache   = map[sunCacheKey]SunPosition{}
+
+	moonCacheMu sync.Mutex
+	moonCache   = map[int64]MoonPosition{}
+)
+
+// Sun returns the sun's altitude and azimuth at t (any time zone; it's
+// converted to UTC internally) as seen from (lat, lon) in degrees.
+// Repeated calls for the same rounded hour and rounded-to-the-degree
+// location are served from an in-memory cache.
+func Sun(t time.Time, lat, lon float64) SunPosition {
+	key := sunCacheKey{hour: t.UTC().Truncate(time.Hour).Unix(), latDeg: int(math.Round(lat)), lonDeg: int(math.Round(lon))}
+
+	sunCacheMu.Lock()
+	if pos, ok := sunCache[key]; ok {
+		sunCacheMu.Unlock()
+		return pos
+	}
+	sunCacheMu.Unlock()
+
+	pos := computeSun(t, lat, lon)
+
+	sunCacheMu.Lock()
+	sunCache[key] = pos
+	sunCacheMu.Unlock()
+	return pos
+}
+
+// Moon returns the moon's synodic phase at t. Unlike Sun, it doesn't depend
+// on location.
+func Moon(t time.Time) MoonPosition {
+	key := t.UTC().Truncate(time.Hour).Unix()
+
+	moonCacheMu.Lock()
+	if pos, ok := moonCache[key]; ok {
+		moonCacheMu.Unlock()
+		return pos
+	}
+	moonCacheMu.Unlock()
+
+	pos := computeMoon(t)
+
+	moonCacheMu.Lock()
+	moonCache[key] = pos
+	moonCacheMu.Unlock()
+	return pos
+}
+
+// sunEquatorial holds the sun's right ascension and declination, both in
+// degrees, for a given Julian day number n (days since J2000.0).
+type sunEquatorial struct {
+	raDeg  float64
+	decDeg float64
+}
+
+func computeSun(t time.Time, lat, lon float64) SunPosition {
+	n := julianDay(t) - 2451545.0
+
+	meanLongitude := mod360(280.460 + 0.9856474*n)
+	meanAnomaly := mod360(357.528 + 0.9856003*n)
+	gRad := deg2rad(meanAnomaly)
+
+	eclipticLongitude := meanLongitude + 1.915*math.Sin(gRad) + 0.020*math.Sin(2*gRad)
+	lambdaRad := deg2rad(eclipticLongitude)
+
+	obliquity := 23.439 - 0.0000004*n
+	epsilonRad := deg2rad(obliquity)
+
+	eq := sunEquatorial{
+		raDeg:  mod360(rad2deg(math.Atan2(math.Cos(epsilonRad)*math.Sin(lambdaRad), math.Cos(lambdaRad)))),
+		decDeg: rad2deg(math.Asin(math.Sin(epsilonRad) * math.Sin(lambdaRad))),
+	}
+
+	gmstDeg := mod360(280.46061837 + 360.98564736629*n)
+	hourAngleDeg := mod360(gmstDeg + lon - eq.raDeg)
+
+	latRad := deg2rad(lat)
+	decRad := deg2rad(eq.decDeg)
+	hRad := deg2rad(hourAngleDeg)
+
+	altitude := math.Asin(math.Sin(latRad)*math.Sin(decRad) + math.Cos(latRad)*math.Cos(decRad)*math.Cos(hRad))
+	azimuth := math.Atan2(-math.Sin(hRad), math.Tan(decRad)*math.Cos(latRad)-math.Sin(latRad)*math.Cos(hRad))
+
+	return SunPosition{
+		AltitudeDeg: rad2deg(altitude),
+		AzimuthDeg:  mod360(rad2deg(azimuth)),
+	}
+}
+
+func computeMoon(t time.Time) MoonPosition {
+	jd := julianDay(t)
+	age := mod1((jd - 2451550.1) / 29.530588853)
+	phaseDeg := age * 360
+	illumination := (1 - math.Cos(deg2rad(phaseDeg))) / 2
+
+	return MoonPosition{PhaseDeg: phaseDeg, Illumination: illumination}
+}
+
+// NextSunrise finds the next time after from at which the sun's altitude
+// crosses from below to above the horizon at (lat, lon), searching forward
+// in 5-minute steps up to 48 hours.
+func NextSunrise(from time.Time, lat, lon float64) (time.Time, bool) {
+	return nextCrossing(from, lat, lon, true)
+}
+
+// NextSunset finds the next time after from at which the sun's altitude
+// crosses from above to below the horizon at (lat, lon), searching forward
+// in 5-minute steps up to 48 hours.
+func NextSunset(from time.Time, lat, lon float64) (time.Time, bool) {
+	return nextCrossing(from, lat, lon, false)
+}
+
+func nextCrossing(from time.Time, lat, lon float64, rising bool) (time.Time, bool) {
+	const (
+		step    = 5 * time.Minute
+		maxScan = 48 * time.Hour
+	)
+
+	prevAltitude := computeSun(from, lat, lon).AltitudeDeg
+	for elapsed := step; elapsed <= maxScan; elapsed += step {
+		t := from.Add(elapsed)
+		altitude := computeSun(t, lat, lon).AltitudeDeg
+
+		crossedUp := prevAltitude <= 0 && altitude > 0
+		crossedDown := prevAltitude >= 0 && altitude < 0
+		if (rising && crossedUp) || (!rising && crossedDown) {
+			return t, true
+		}
+		prevAltitude = altitude
+	}
+	return time.Time{}, false
+}
+
+// EnrichEvent loads the :Event node identified by eventID's startsAt and
+// location properties, computes sun/moon state, and writes sunAltitude,
+// moonPhase, isDaylight and nextSunrise back onto the node.
+func EnrichEvent(ctx context.Context, driver neo4j.DriverWithContext, eventID string) error {
+	result, err := neo4j.ExecuteQuery(ctx, driver,
+		"MATCH (e:Event {id: $id}) RETURN e.startsAt AS startsAt, e.location AS location",
+		map[string]any{"id": eventID},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return fmt.Errorf("astro: read event %s: %w", eventID, err)
+	}
+	if len(result.Records) == 0 {
+		return fmt.Errorf("astro: event %s not found", eventID)
+	}
+
+	startsAtRaw, _ := result.Records[0].Get("startsAt")
+	startsAt, err := asTime(startsAtRaw)
+	if err != nil {
+		return fmt.Errorf("astro: event %s startsAt: %w", eventID, err)
+	}
+
+	locationRaw, _ := result.Records[0].Get("location")
+	lat, lon, err := latLonOf(locationRaw)
+	if err != nil {
+		return fmt.Errorf("astro: event %s location: %w", eventID, err)
+	}
+
+	sun := Sun(startsAt, lat, lon)
+	moon := Moon(startsAt)
+
+	var nextSunrise any
+	if sunrise, ok := NextSunrise(startsAt, lat, lon); ok {
+		nextSunrise = sunrise
+	}
+
+	_, err = neo4j.ExecuteQuery(ctx, driver,
+		`MATCH (e:Event {id: $id})
+		 SET e.sunAltitude = $sunAltitude, e.moonPhase = $moonPhase,
+		     e.isDaylight = $isDaylight, e.nextSunrise = $nextSunrise`,
+		map[string]any{
+			"id":          eventID,
+			"sunAltitude": sun.AltitudeDeg,
+			"moonPhase":   moon.PhaseDeg,
+			"isDaylight":  sun.IsDaylight(),
+			"nextSunrise": nextSunrise,
+		},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return fmt.Errorf("astro: enrich event %s: %w", eventID, err)
+	}
+	return nil
+}
+
+func asTime(v any) (time.Time, error) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, nil
+	case neo4j.LocalDateTime:
+		return t.Time(), nil
+	case neo4j.Date:
+		return t.Time(), nil
+	default:
+		return time.Time{}, fmt.Errorf("unsupported temporal type %T", v)
+	}
+}
+
+func latLonOf(v any) (lat, lon float64, err error) {
+	switch p := v.(type) {
+	case neo4j.Point2D:
+		return p.Y, p.X, nil
+	case neo4j.Point3D:
+		return p.Y, p.X, nil
+	default:
+		return 0, 0, fmt.Errorf("unsupported location type %T", v)
+	}
+}
+
+func mod360(deg float64) float64 {
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg
+}
+
+func mod1(x float64) float64 {
+	x = math.Mod(x, 1)
+	if x < 0 {
+		x++
+	}
+	return x
+}
+
+func deg2rad(deg float64) float64 { return deg * math.Pi / 180 }
+func rad2deg(rad float64) float64 { return rad * 180 / math.Pi }
+
+// julianDay converts t (in any time zone; it's converted to UTC) to its
+// Julian day number, per the standard Gregorian-calendar formula.
+func julianDay(t time.Time) float64 {
+	t = t.UTC()
+	year, month, day := t.Year(), int(t.Month()), t.Day()
+	if month <= 2 {
+		year--
+		month += 12
+	}
+	a := year / 100
+	b := 2 - a + a/4
+
+	dayFrac := float64(day) + (float64(t.Hour())+float64(t.Minute())/60+float64(t.Second())/3600)/24
+
+	return math.Floor(365.25*(float64(year)+4716)) +
+		math.Floor(30.6001*(float64(month)+1)) +
+		dayFrac + float64(b) - 1524.5
+}
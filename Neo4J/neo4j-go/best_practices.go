@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/rupeedev/ai-pack/neo4j-go/internal/config"
+	"github.com/rupeedev/ai-pack/neo4j-go/neo4jx"
 )
 
 // Example 1: Basic Session Management
@@ -20,6 +22,12 @@ func basicSessionManagement(ctx context.Context, driver neo4j.DriverWithContext)
 	fmt.Println("  Use defer to ensure session is closed when done")
 }
 
+// recentMovie is Example 2's row shape: a Movie's title and release year.
+type recentMovie struct {
+	Title    string `neo4j:"title"`
+	Released int64  `neo4j:"released"`
+}
+
 // Example 2: Using ExecuteRead for Read Queries
 func executeReadExample(ctx context.Context, driver neo4j.DriverWithContext) {
 	fmt.Println("\n=== Example 2: Using ExecuteRead ===")
@@ -28,7 +36,7 @@ func executeReadExample(ctx context.Context, driver neo4j.DriverWithContext) {
 	defer session.Close(ctx)
 
 	// ExecuteRead optimizes for read operations (can use followers in cluster)
-	result, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+	movies, err := neo4jx.ExecuteRead(ctx, session, func(tx neo4j.ManagedTransaction) ([]recentMovie, error) {
 		cypher := `
 			MATCH (m:Movie)
 			RETURN m.title AS title, m.released AS released
@@ -40,18 +48,7 @@ func executeReadExample(ctx context.Context, driver neo4j.DriverWithContext) {
 		if err != nil {
 			return nil, err
 		}
-
-		// Collect results
-		var movies []map[string]any
-		for result.Next(ctx) {
-			record := result.Record()
-			movies = append(movies, map[string]any{
-				"title":    record.Values[0],
-				"released": record.Values[1],
-			})
-		}
-
-		return movies, result.Err()
+		return neo4jx.Scan[recentMovie](ctx, result)
 	})
 
 	if err != nil {
@@ -60,9 +57,8 @@ func executeReadExample(ctx context.Context, driver neo4j.DriverWithContext) {
 	}
 
 	fmt.Println("Recent movies:")
-	movies := result.([]map[string]any)
 	for i, movie := range movies {
-		fmt.Printf("  %d. %s (%v)\n", i+1, movie["title"], movie["released"])
+		fmt.Printf("  %d. %s (%v)\n", i+1, movie.Title, movie.Released)
 	}
 }
 
@@ -74,7 +70,7 @@ func executeWriteExample(ctx context.Context, driver neo4j.DriverWithContext) {
 	defer session.Close(ctx)
 
 	// ExecuteWrite optimizes for write operations (uses leader in cluster)
-	result, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+	person, err := neo4jx.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (neo4j.Node, error) {
 		cypher := `
 			CREATE (p:Person {name: $name, role: $role})
 			RETURN p
@@ -85,16 +81,16 @@ func executeWriteExample(ctx context.Context, driver neo4j.DriverWithContext) {
 			"role": "Developer",
 		})
 		if err != nil {
-			return nil, err
+			return neo4j.Node{}, err
 		}
 
 		record, err := result.Single(ctx)
 		if err != nil {
-			return nil, err
+			return neo4j.Node{}, err
 		}
 
 		node, _ := record.Get("p")
-		return node, nil
+		return node.(neo4j.Node), nil
 	})
 
 	if err != nil {
@@ -102,7 +98,6 @@ func executeWriteExample(ctx context.Context, driver neo4j.DriverWithContext) {
 		return
 	}
 
-	person := result.(neo4j.Node)
 	fmt.Printf("✓ Created person: %s (role: %s)\n",
 		person.Props["name"],
 		person.Props["role"])
@@ -143,7 +138,7 @@ func unitOfWorkPattern(ctx context.Context, driver neo4j.DriverWithContext) {
 	}
 
 	// Use the transaction function
-	result, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+	person, err := neo4jx.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (neo4j.Node, error) {
 		return createPerson(tx, "Alice", 30)
 	})
 
@@ -152,7 +147,6 @@ func unitOfWorkPattern(ctx context.Context, driver neo4j.DriverWithContext) {
 		return
 	}
 
-	person := result.(neo4j.Node)
 	fmt.Printf("✓ Created person: %s (age: %v)\n",
 		person.Props["name"],
 		person.Props["age"])
@@ -166,6 +160,12 @@ func unitOfWorkPattern(ctx context.Context, driver neo4j.DriverWithContext) {
 	})
 }
 
+// accountBalance is an Account's id and balance.
+type accountBalance struct {
+	ID      string  `neo4j:"id"`
+	Balance float64 `neo4j:"balance"`
+}
+
 // Example 5: Multiple Queries in One Transaction
 func multipleQueriesInTransaction(ctx context.Context, driver neo4j.DriverWithContext) {
 	fmt.Println("\n=== Example 5: Multiple Queries in One Transaction ===")
@@ -174,7 +174,7 @@ func multipleQueriesInTransaction(ctx context.Context, driver neo4j.DriverWithCo
 	defer session.Close(ctx)
 
 	// Setup: Create two accounts
-	session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+	neo4jx.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (any, error) {
 		tx.Run(ctx, `
 			CREATE (a1:Account {id: 'ACC001', balance: 1000.0})
 			CREATE (a2:Account {id: 'ACC002', balance: 500.0})
@@ -201,7 +201,7 @@ func multipleQueriesInTransaction(ctx context.Context, driver neo4j.DriverWithCo
 		return err
 	}
 
-	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+	_, err := neo4jx.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (any, error) {
 		return nil, transferFunds(tx, "ACC001", "ACC002", 200.0)
 	})
 
@@ -211,7 +211,7 @@ func multipleQueriesInTransaction(ctx context.Context, driver neo4j.DriverWithCo
 	}
 
 	// Verify balances
-	result, _ := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+	accounts, _ := neo4jx.ExecuteRead(ctx, session, func(tx neo4j.ManagedTransaction) ([]accountBalance, error) {
 		result, err := tx.Run(ctx, `
 			MATCH (a:Account)
 			WHERE a.id IN ['ACC001', 'ACC002']
@@ -221,23 +221,13 @@ func multipleQueriesInTransaction(ctx context.Context, driver neo4j.DriverWithCo
 		if err != nil {
 			return nil, err
 		}
-
-		var accounts []map[string]any
-		for result.Next(ctx) {
-			record := result.Record()
-			accounts = append(accounts, map[string]any{
-				"id":      record.Values[0],
-				"balance": record.Values[1],
-			})
-		}
-		return accounts, result.Err()
+		return neo4jx.Scan[accountBalance](ctx, result)
 	})
 
 	fmt.Println("✓ Funds transferred successfully")
 	fmt.Println("Account balances after transfer:")
-	accounts := result.([]map[string]any)
 	for _, account := range accounts {
-		fmt.Printf("  %s: $%.2f\n", account["id"], account["balance"])
+		fmt.Printf("  %s: $%.2f\n", account.ID, account.Balance)
 	}
 	fmt.Println("  Both operations completed or none (atomic transaction)")
 
@@ -313,22 +303,21 @@ func transactionRollbackExample(ctx context.Context, driver neo4j.DriverWithCont
 	}
 
 	// Verify actor was NOT created
-	result, _ := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+	count, _ := neo4jx.ExecuteRead(ctx, session, func(tx neo4j.ManagedTransaction) (int64, error) {
 		result, err := tx.Run(ctx,
 			"MATCH (p:Person {name: 'Rollback Test Actor'}) RETURN count(p) AS count",
 			nil)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 		record, err := result.Single(ctx)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 		count, _ := record.Get("count")
-		return count, nil
+		return count.(int64), nil
 	})
 
-	count := result.(int64)
 	if count == 0 {
 		fmt.Printf("✓ Verified: Actor was NOT created (count: %d)\n", count)
 	} else {
@@ -344,7 +333,7 @@ func resultConsumptionExample(ctx context.Context, driver neo4j.DriverWithContex
 	defer session.Close(ctx)
 
 	// Execute a write query and get summary
-	summary, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+	summary, err := neo4jx.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (neo4j.ResultSummary, error) {
 		result, err := tx.Run(ctx, `
 			CREATE (p1:Person {name: 'Bob'}),
 			       (p2:Person {name: 'Carol'}),
@@ -364,14 +353,13 @@ func resultConsumptionExample(ctx context.Context, driver neo4j.DriverWithContex
 		return
 	}
 
-	summaryObj := summary.(neo4j.ResultSummary)
 	fmt.Println("Transaction Summary:")
-	fmt.Printf("  Nodes created: %d\n", summaryObj.Counters().NodesCreated())
-	fmt.Printf("  Relationships created: %d\n", summaryObj.Counters().RelationshipsCreated())
-	fmt.Printf("  Properties set: %d\n", summaryObj.Counters().PropertiesSet())
-	fmt.Printf("  Results available after: %d ms\n", summaryObj.ResultAvailableAfter().Milliseconds())
-	fmt.Printf("  Results consumed after: %d ms\n", summaryObj.ResultConsumedAfter().Milliseconds())
-	fmt.Printf("  Statement type: %s\n", summaryObj.StatementType())
+	fmt.Printf("  Nodes created: %d\n", summary.Counters().NodesCreated())
+	fmt.Printf("  Relationships created: %d\n", summary.Counters().RelationshipsCreated())
+	fmt.Printf("  Properties set: %d\n", summary.Counters().PropertiesSet())
+	fmt.Printf("  Results available after: %d ms\n", summary.ResultAvailableAfter().Milliseconds())
+	fmt.Printf("  Results consumed after: %d ms\n", summary.ResultConsumedAfter().Milliseconds())
+	fmt.Printf("  Statement type: %s\n", summary.StatementType())
 
 	// Cleanup
 	session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
@@ -391,19 +379,19 @@ func specifyingDatabaseExample(ctx context.Context, driver neo4j.DriverWithConte
 	})
 	defer session.Close(ctx)
 
-	result, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+	count, err := neo4jx.ExecuteRead(ctx, session, func(tx neo4j.ManagedTransaction) (int64, error) {
 		result, err := tx.Run(ctx,
 			"RETURN COUNT {()} AS count",
 			nil)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 		record, err := result.Single(ctx)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 		count, _ := record.Get("count")
-		return count, nil
+		return count.(int64), nil
 	})
 
 	if err != nil {
@@ -411,7 +399,6 @@ func specifyingDatabaseExample(ctx context.Context, driver neo4j.DriverWithConte
 		return
 	}
 
-	count := result.(int64)
 	fmt.Printf("✓ Queried 'neo4j' database: %d nodes\n", count)
 	fmt.Println("  Use SessionConfig.DatabaseName to specify database")
 }
@@ -473,22 +460,12 @@ func bestPracticesSummary(ctx context.Context, driver neo4j.DriverWithContext) {
 
 func runBestPracticesExamples() {
 	// Setup driver
-	driver, err := neo4j.NewDriverWithContext(
-		"neo4j://localhost:7687",
-		neo4j.BasicAuth("neo4j", "Your@Password!@#", ""),
-	)
-	if err != nil {
-		panic(err)
-	}
-	defer driver.Close(context.Background())
-
 	ctx := context.Background()
-
-	// Verify connection
-	err = driver.VerifyConnectivity(ctx)
+	driver, err := config.DriverFromEnv(ctx)
 	if err != nil {
-		panic(fmt.Sprintf("Failed to connect: %v", err))
+		panic(err)
 	}
+	defer driver.Close(ctx)
 
 	fmt.Println("Connected to Neo4j successfully!")
 	fmt.Println("========================================")
@@ -0,0 +1,203 @@
+// Package bind provides a generic result transformer that binds neo4j.Record
+// values onto caller-defined structs using `neo4j` struct tags, so callers no
+// longer need to hand-write record.Get(...) plus a .(type) assertion for
+// every column.
+package bind
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// LatLng is the Go-friendly representation a bound neo4j.Point2D is converted
+// to when the destination field is not itself a neo4j.Point2D.
+type LatLng struct {
+	Lat float64
+	Lng float64
+}
+
+// StructResultTransformer returns a function suitable for passing straight
+// to neo4j.ExecuteQuery as its newResultTransformer argument. Each record is
+// bound onto a new value of T according to its `neo4j` struct tags:
+//
+//	`neo4j:"actor"`             - scalar column "actor" bound directly
+//	`neo4j:"m,node"`            - column "m" is a neo4j.Node; its properties
+//	                              populate the tagged field's own struct,
+//	                              whose fields use `neo4j:"prop=title"`
+//	`neo4j:"r,relationship"`    - same as above, for a neo4j.Relationship
+//	`neo4j:"path"`              - column "path" bound directly (neo4j.Path)
+//
+// neo4j.Date and neo4j.LocalDateTime columns convert to time.Time, and
+// neo4j.Point2D converts to LatLng, whenever the destination field isn't
+// already one of those neo4j types.
+func StructResultTransformer[T any]() func() neo4j.ResultTransformer[[]T] {
+	return func() neo4j.ResultTransformer[[]T] {
+		return &structTransformer[T]{}
+	}
+}
+
+type structTransformer[T any] struct {
+	rows []T
+}
+
+func (s *structTransformer[T]) Accept(record *neo4j.Record) error {
+	var row T
+	if err := bindRecord(record, &row); err != nil {
+		return fmt.Errorf("bind: %w", err)
+	}
+	s.rows = append(s.rows, row)
+	return nil
+}
+
+func (s *structTransformer[T]) Complete([]string, neo4j.ResultSummary) ([]T, error) {
+	return s.rows, nil
+}
+
+// fieldTag is the parsed form of a `neo4j:"..."` struct tag.
+type fieldTag struct {
+	column string // record key, or node/relationship property name for nested fields
+	kind   string // "", "node", "relationship" or "path"
+	isProp bool   // true for nested "prop=..." tags
+}
+
+func parseTag(raw string) (fieldTag, bool) {
+	if raw == "" || raw == "-" {
+		return fieldTag{}, false
+	}
+	parts := strings.Split(raw, ",")
+	first := parts[0]
+	if name, ok := strings.CutPrefix(first, "prop="); ok {
+		return fieldTag{column: name, isProp: true}, true
+	}
+	tag := fieldTag{column: first}
+	if len(parts) > 1 {
+		tag.kind = parts[1]
+	} else if first == "path" {
+		tag.kind = "path"
+	}
+	return tag, true
+}
+
+func bindRecord(record *neo4j.Record, dst any) error {
+	v := reflect.ValueOf(dst).Elem()
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, ok := parseTag(field.Tag.Get("neo4j"))
+		if !ok {
+			continue
+		}
+
+		raw, found := record.Get(tag.column)
+		if !found || raw == nil {
+			continue
+		}
+
+		fv := v.Field(i)
+		switch tag.kind {
+		case "node":
+			node, ok := raw.(neo4j.Node)
+			if !ok {
+				return fmt.Errorf("column %q: expected neo4j.Node, got %T", tag.column, raw)
+			}
+			if err := bindProps(node.Props, fv); err != nil {
+				return fmt.Errorf("column %q: %w", tag.column, err)
+			}
+		case "relationship":
+			rel, ok := raw.(neo4j.Relationship)
+			if !ok {
+				return fmt.Errorf("column %q: expected neo4j.Relationship, got %T", tag.column, raw)
+			}
+			if err := bindProps(rel.Props, fv); err != nil {
+				return fmt.Errorf("column %q: %w", tag.column, err)
+			}
+		case "path":
+			if err := setValue(fv, raw); err != nil {
+				return fmt.Errorf("column %q: %w", tag.column, err)
+			}
+		default:
+			if err := setValue(fv, raw); err != nil {
+				return fmt.Errorf("column %q: %w", tag.column, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// bindProps fills the fields of a nested struct (fv) from a node or
+// relationship property map, using each field's `neo4j:"prop=..."` tag.
+func bindProps(props map[string]any, fv reflect.Value) error {
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		fv = fv.Elem()
+	}
+	if fv.Kind() != reflect.Struct {
+		return fmt.Errorf("destination field must be a struct, got %s", fv.Kind())
+	}
+
+	ft := fv.Type()
+	for i := 0; i < ft.NumField(); i++ {
+		nested := ft.Field(i)
+		tag, ok := parseTag(nested.Tag.Get("neo4j"))
+		if !ok || !tag.isProp {
+			continue
+		}
+		raw, present := props[tag.column]
+		if !present || raw == nil {
+			continue
+		}
+		if err := setValue(fv.Field(i), raw); err != nil {
+			return fmt.Errorf("property %q: %w", tag.column, err)
+		}
+	}
+	return nil
+}
+
+// setValue assigns raw onto dst, converting Neo4j temporal and spatial types
+// to their Go equivalents when dst isn't already the matching Neo4j type.
+func setValue(dst reflect.Value, raw any) error {
+	if converted, ok := convertNeo4jValue(raw, dst.Type()); ok {
+		dst.Set(reflect.ValueOf(converted))
+		return nil
+	}
+
+	rv := reflect.ValueOf(raw)
+	if rv.Type().AssignableTo(dst.Type()) {
+		dst.Set(rv)
+		return nil
+	}
+	if rv.Type().ConvertibleTo(dst.Type()) {
+		dst.Set(rv.Convert(dst.Type()))
+		return nil
+	}
+	return fmt.Errorf("cannot assign %T to %s", raw, dst.Type())
+}
+
+func convertNeo4jValue(raw any, target reflect.Type) (any, bool) {
+	switch v := raw.(type) {
+	case neo4j.Date:
+		if target == reflect.TypeOf(v) {
+			return nil, false
+		}
+		return v.Time(), true
+	case neo4j.LocalDateTime:
+		if target == reflect.TypeOf(v) {
+			return nil, false
+		}
+		return v.Time(), true
+	case neo4j.Point2D:
+		if target == reflect.TypeOf(v) {
+			return nil, false
+		}
+		return LatLng{Lat: v.Y, Lng: v.X}, true
+	default:
+		return nil, false
+	}
+}
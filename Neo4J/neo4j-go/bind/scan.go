@@ -0,0 +1,37 @@
+package bind
+
+import (
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// ScanAll binds every record of an already-executed EagerResult onto a new
+// []T, using the same `neo4j` struct tags as StructResultTransformer. It's
+// the thin-layer counterpart for call sites that already have an
+// EagerResult in hand (e.g. from neo4j.EagerResultTransformer) rather than
+// wiring StructResultTransformer into neo4j.ExecuteQuery directly.
+func ScanAll[T any](result *neo4j.EagerResult) ([]T, error) {
+	rows := make([]T, 0, len(result.Records))
+	for _, record := range result.Records {
+		var row T
+		if err := bindRecord(record, &row); err != nil {
+			return nil, fmt.Errorf("bind: %w", err)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// ScanOne binds the first record of result onto a T, returning an error if
+// result has no records.
+func ScanOne[T any](result *neo4j.EagerResult) (T, error) {
+	var row T
+	if len(result.Records) == 0 {
+		return row, fmt.Errorf("bind: result has no records")
+	}
+	if err := bindRecord(result.Records[0], &row); err != nil {
+		return row, fmt.Errorf("bind: %w", err)
+	}
+	return row, nil
+}
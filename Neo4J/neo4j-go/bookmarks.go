@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/rupeedev/ai-pack/neo4j-go/internal/config"
+)
+
+// Example 1: Shared BookmarkManager across sessions
+func sharedBookmarkManager(ctx context.Context, driver neo4j.DriverWithContext) {
+	fmt.Println("\n=== Example 1: Shared BookmarkManager ===")
+
+	bookmarkManager := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{})
+
+	writeSession := driver.NewSession(ctx, neo4j.SessionConfig{BookmarkManager: bookmarkManager})
+	defer writeSession.Close(ctx)
+
+	_, err := writeSession.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return tx.Run(ctx, `MERGE (p:Person {name: "Bookmark Demo Actor"}) SET p.born = 1975`, nil)
+	})
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println("✓ Wrote Person in session 1")
+
+	readSession := driver.NewSession(ctx, neo4j.SessionConfig{BookmarkManager: bookmarkManager})
+	defer readSession.Close(ctx)
+
+	result, err := readSession.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx,
+			"MATCH (p:Person {name: 'Bookmark Demo Actor'}) RETURN count(p) AS count",
+			nil)
+		if err != nil {
+			return nil, err
+		}
+		record, err := result.Single(ctx)
+		if err != nil {
+			return nil, err
+		}
+		count, _ := record.Get("count")
+		return count, nil
+	})
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	count := result.(int64)
+	fmt.Printf("✓ Session 2 sees the write immediately (count: %d)\n", count)
+	fmt.Println("  Both sessions share a BookmarkManager, so session 2's read is")
+	fmt.Println("  guaranteed to happen after session 1's write (causal consistency).")
+
+	// Cleanup
+	writeSession.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return tx.Run(ctx, "MATCH (p:Person {name: 'Bookmark Demo Actor'}) DELETE p", nil)
+	})
+}
+
+// Example 2: Propagating bookmarks by hand between sessions that don't
+// share a BookmarkManager
+func manualBookmarkPropagation(ctx context.Context, driver neo4j.DriverWithContext) {
+	fmt.Println("\n=== Example 2: Manual Bookmark Propagation ===")
+
+	session1 := driver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session1.Close(ctx)
+
+	_, err := session1.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return tx.Run(ctx, `CREATE (p:Person {name: "Manual Bookmark Actor"})`, nil)
+	})
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	bookmarks := session1.LastBookmarks()
+	fmt.Println("✓ Captured session 1's bookmarks after the write")
+
+	// A fresh session, seeded with session 1's bookmarks, is guaranteed to
+	// see everything session 1 committed - even on a different cluster member.
+	session2 := driver.NewSession(ctx, neo4j.SessionConfig{Bookmarks: bookmarks})
+	defer session2.Close(ctx)
+
+	result, err := session2.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx,
+			"MATCH (p:Person {name: 'Manual Bookmark Actor'}) RETURN count(p) AS count",
+			nil)
+		if err != nil {
+			return nil, err
+		}
+		record, err := result.Single(ctx)
+		if err != nil {
+			return nil, err
+		}
+		count, _ := record.Get("count")
+		return count, nil
+	})
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	count := result.(int64)
+	fmt.Printf("✓ Session 2 (seeded with session 1's bookmarks) sees the write (count: %d)\n", count)
+
+	// Cleanup
+	session1.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return tx.Run(ctx, "MATCH (p:Person {name: 'Manual Bookmark Actor'}) DELETE p", nil)
+	})
+}
+
+func runBookmarkExamples() {
+	ctx := context.Background()
+	driver, err := config.DriverFromEnv(ctx)
+	if err != nil {
+		panic(err)
+	}
+	defer driver.Close(ctx)
+
+	fmt.Println("Connected to Neo4j successfully!")
+	fmt.Println("========================================")
+
+	sharedBookmarkManager(ctx, driver)
+	manualBookmarkPropagation(ctx, driver)
+
+	fmt.Println("\n========================================")
+	fmt.Println("All bookmark examples completed!")
+}
@@ -0,0 +1,116 @@
+package bulk
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// RowMapper converts one CSV record (plus the file's header row, for
+// column-name lookups) into a row of type T. GTFS-style feeds need this
+// rather than a generic struct tag mapping, since columns like stop_lat/
+// stop_lon must be combined into a single neo4j.Point2D field.
+type RowMapper[T any] func(record, header []string) (T, error)
+
+// LoadCSV streams path, converts each record to a T via mapRow, and flushes
+// full w.batchSize() batches to WriteNodes as it goes - the file is never
+// held in memory all at once.
+func LoadCSV[T any](ctx context.Context, driver neo4j.DriverWithContext, w *Writer, label, path string, mapRow RowMapper[T]) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("bulk: open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(bufio.NewReader(f))
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("bulk: read header from %s: %w", path, err)
+	}
+
+	batchSize := w.batchSize()
+	batch := make([]T, 0, batchSize)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := WriteNodes(ctx, driver, w, label, batch); err != nil {
+			return err
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("bulk: read record from %s: %w", path, err)
+		}
+
+		row, err := mapRow(record, header)
+		if err != nil {
+			return fmt.Errorf("bulk: map record from %s: %w", path, err)
+		}
+		batch = append(batch, row)
+
+		if len(batch) == batchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+// LoadJSONL streams path as newline-delimited JSON, decoding each line
+// straight into a T, and flushes full w.batchSize() batches to WriteNodes
+// as it goes.
+func LoadJSONL[T any](ctx context.Context, driver neo4j.DriverWithContext, w *Writer, label, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("bulk: open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	decoder := json.NewDecoder(bufio.NewReader(f))
+
+	batchSize := w.batchSize()
+	batch := make([]T, 0, batchSize)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := WriteNodes(ctx, driver, w, label, batch); err != nil {
+			return err
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for decoder.More() {
+		var row T
+		if err := decoder.Decode(&row); err != nil {
+			return fmt.Errorf("bulk: decode record from %s: %w", path, err)
+		}
+		batch = append(batch, row)
+
+		if len(batch) == batchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/rupeedev/ai-pack/neo4j-go/internal/config"
+)
+
+const (
+	// bulkIngestNaiveRows is deliberately far smaller than
+	// bulkIngestTotalRows: the naive per-row neo4j.ExecuteQuery path opens
+	// a fresh managed transaction for every single row, so running it at
+	// the same scale as the batched path would make this example take
+	// minutes just to demonstrate the anti-pattern it contrasts with.
+	bulkIngestNaiveRows = 500
+
+	bulkIngestTotalRows   = 5000
+	bulkIngestBatchSize   = 250
+	bulkIngestConcurrency = 4
+)
+
+// ingestMetrics accumulates one latency sample per write (one row for the
+// naive strategy, one batch for the UNWIND strategy) plus pass/fail counts,
+// for reporting aggregate throughput and p50/p95 latency.
+type ingestMetrics struct {
+	mu         sync.Mutex
+	latencies  []time.Duration
+	rowsDone   int64
+	rowsFailed int64
+}
+
+func (m *ingestMetrics) record(rows int, latency time.Duration, err error) {
+	if err != nil {
+		atomic.AddInt64(&m.rowsFailed, int64(rows))
+		return
+	}
+	atomic.AddInt64(&m.rowsDone, int64(rows))
+	m.mu.Lock()
+	m.latencies = append(m.latencies, latency)
+	m.mu.Unlock()
+}
+
+func (m *ingestMetrics) report(label string, elapsed time.Duration) {
+	done := atomic.LoadInt64(&m.rowsDone)
+	failed := atomic.LoadInt64(&m.rowsFailed)
+	fmt.Printf("✓ %s: %d rows (%d failed) in %s\n", label, done, failed, elapsed)
+	if elapsed.Seconds() > 0 {
+		fmt.Printf("  Throughput: %.0f rows/sec\n", float64(done)/elapsed.Seconds())
+	}
+
+	m.mu.Lock()
+	p50, p95 := percentile(m.latencies, 0.50), percentile(m.latencies, 0.95)
+	n := len(m.latencies)
+	m.mu.Unlock()
+	fmt.Printf("  ResultAvailableAfter: p50=%s p95=%s (n=%d writes)\n", p50, p95, n)
+}
+
+// percentile returns the p-th percentile (0 <= p <= 1) of latencies, which
+// is sorted in place. Returns 0 for an empty slice.
+func percentile(latencies []time.Duration, p float64) time.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	idx := int(p * float64(len(latencies)-1))
+	return latencies[idx]
+}
+
+func syntheticRating(i int, movies []string) map[string]any {
+	return map[string]any{
+		"id":    fmt.Sprintf("viewer-%d", i),
+		"name":  fmt.Sprintf("Viewer %d", i),
+		"movie": movies[i%len(movies)],
+		"stars": i%5 + 1,
+	}
+}
+
+// naiveIngestExample writes one row at a time with neo4j.ExecuteQuery, the
+// anti-pattern this whole example contrasts with: every row pays for its
+// own managed transaction and network round trip instead of amortizing
+// both across a batch.
+func naiveIngestExample(ctx context.Context, driver neo4j.DriverWithContext) {
+	fmt.Println("\n=== Bulk Ingest: Naive Per-Row ExecuteQuery ===")
+
+	movies := []string{"The Matrix", "Toy Story", "Forrest Gump", "Cast Away", "Apollo 13"}
+	metrics := &ingestMetrics{}
+	start := time.Now()
+
+	for i := 0; i < bulkIngestNaiveRows; i++ {
+		result, err := neo4j.ExecuteQuery(ctx, driver, `
+			MERGE (v:Viewer {id: $id})
+			SET v.name = $name
+			MERGE (m:Movie {title: $movie})
+			MERGE (v)-[r:RATED]->(m)
+			SET r.stars = $stars
+		`, syntheticRating(i, movies), neo4j.EagerResultTransformer)
+		if err != nil {
+			metrics.record(1, 0, err)
+			continue
+		}
+		metrics.record(1, result.Summary.ResultAvailableAfter(), nil)
+	}
+
+	metrics.report("Naive per-row", time.Since(start))
+}
+
+// batchedIngestExample generates synthetic viewer ratings and writes them
+// with concurrent UNWIND-batched transactions, reporting throughput and
+// per-batch latency metrics.
+func batchedIngestExample(ctx context.Context, driver neo4j.DriverWithContext) {
+	fmt.Println("\n=== Bulk Ingest: Concurrent UNWIND Batches ===")
+
+	batches := make(chan []map[string]any, bulkIngestConcurrency)
+	metrics := &ingestMetrics{}
+
+	var wg sync.WaitGroup
+	start := time.Now()
+
+	for worker := 0; worker < bulkIngestConcurrency; worker++ {
+		wg.Add(1)
+		go func(workerID int) {
+			defer wg.Done()
+
+			session := driver.NewSession(ctx, neo4j.SessionConfig{})
+			defer session.Close(ctx)
+
+			for batch := range batches {
+				raw, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+					result, err := tx.Run(ctx, `
+						UNWIND $batch AS row
+						MERGE (v:Viewer {id: row.id})
+						SET v.name = row.name
+						MERGE (m:Movie {title: row.movie})
+						MERGE (v)-[r:RATED]->(m)
+						SET r.stars = row.stars
+					`, map[string]any{"batch": batch})
+					if err != nil {
+						return nil, err
+					}
+					return result.Consume(ctx)
+				})
+				if err != nil {
+					metrics.record(len(batch), 0, err)
+					fmt.Printf("  worker %d: batch failed: %v\n", workerID, err)
+					continue
+				}
+				summary := raw.(neo4j.ResultSummary)
+				metrics.record(len(batch), summary.ResultAvailableAfter(), nil)
+			}
+		}(worker)
+	}
+
+	movies := []string{"The Matrix", "Toy Story", "Forrest Gump", "Cast Away", "Apollo 13"}
+	batch := make([]map[string]any, 0, bulkIngestBatchSize)
+	for i := 0; i < bulkIngestTotalRows; i++ {
+		batch = append(batch, syntheticRating(i, movies))
+		if len(batch) == bulkIngestBatchSize {
+			batches <- batch
+			batch = make([]map[string]any, 0, bulkIngestBatchSize)
+		}
+	}
+	if len(batch) > 0 {
+		batches <- batch
+	}
+	close(batches)
+
+	wg.Wait()
+	metrics.report(fmt.Sprintf("Batched UNWIND (%d workers)", bulkIngestConcurrency), time.Since(start))
+}
+
+// bulkIngestExample runs both ingest strategies back to back so their
+// throughput and latency numbers can be compared directly, then cleans up
+// the synthetic data both strategies wrote.
+func bulkIngestExample(ctx context.Context, driver neo4j.DriverWithContext) {
+	naiveIngestExample(ctx, driver)
+	batchedIngestExample(ctx, driver)
+
+	_, _ = neo4j.ExecuteQuery(ctx, driver,
+		"MATCH (v:Viewer) DETACH DELETE v",
+		nil,
+		neo4j.EagerResultTransformer,
+	)
+}
+
+func runBulkIngestExample() {
+	ctx := context.Background()
+	driver, err := config.DriverFromEnv(ctx)
+	if err != nil {
+		panic(err)
+	}
+	defer driver.Close(ctx)
+
+	fmt.Println("Connected to Neo4j successfully!")
+	fmt.Println("========================================")
+
+	bulkIngestExample(ctx, driver)
+
+	fmt.Println("\n========================================")
+	fmt.Println("Bulk ingest example completed!")
+}
@@ -5,8 +5,18 @@ import (
 	"fmt"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/rupeedev/ai-pack/neo4j-go/bind"
+	"github.com/rupeedev/ai-pack/neo4j-go/internal/config"
 )
 
+// actedInRow is the shape shared by every ACTED_IN query below: an actor, a
+// movie they're in, and the role they played.
+type actedInRow struct {
+	Name  string `neo4j:"name"`
+	Title string `neo4j:"title"`
+	Role  string `neo4j:"role"`
+}
+
 // Example 1: Basic query execution with parameters
 func executeWithParameters(ctx context.Context, driver neo4j.DriverWithContext) {
 	fmt.Println("\n=== Example 1: Query with Parameters ===")
@@ -27,15 +37,24 @@ func executeWithParameters(ctx context.Context, driver neo4j.DriverWithContext)
 		return
 	}
 
-	fmt.Printf("Found %d records\n", len(result.Records))
-	for _, record := range result.Records {
-		actorName, _ := record.Get("name")
-		movieTitle, _ := record.Get("title")
-		role, _ := record.Get("role")
-		fmt.Printf("  %s played %s in %s\n", actorName, role, movieTitle)
+	rows, err := bind.ScanAll[actedInRow](result)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Found %d records\n", len(rows))
+	for _, row := range rows {
+		fmt.Printf("  %s played %s in %s\n", row.Name, row.Role, row.Title)
 	}
 }
 
+// releasedMovieRow is a Movie's title and release year.
+type releasedMovieRow struct {
+	Title    string `neo4j:"title"`
+	Released int64  `neo4j:"released"`
+}
+
 // Example 2: Handling result metadata
 func handleResultMetadata(ctx context.Context, driver neo4j.DriverWithContext) {
 	fmt.Println("\n=== Example 2: Result Metadata ===")
@@ -69,12 +88,16 @@ func handleResultMetadata(ctx context.Context, driver neo4j.DriverWithContext) {
 		}
 	}
 
+	rows, err := bind.ScanAll[releasedMovieRow](result)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
 	// Access records
 	fmt.Println("\nRecent movies:")
-	for _, record := range result.Records {
-		title, _ := record.Get("title")
-		released, _ := record.Get("released")
-		fmt.Printf("  %s (%v)\n", title, released)
+	for _, row := range rows {
+		fmt.Printf("  %s (%v)\n", row.Title, row.Released)
 	}
 }
 
@@ -100,14 +123,17 @@ func customTransformer(ctx context.Context, driver neo4j.DriverWithContext) {
 		return
 	}
 
+	rows, err := bind.ScanAll[actedInRow](result)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
 	// Transform the results manually
 	var roleDescriptions []string
-	for _, record := range result.Records {
-		name, _ := record.Get("name")
-		title, _ := record.Get("title")
-		role, _ := record.Get("role")
+	for _, row := range rows {
 		roleDescriptions = append(roleDescriptions,
-			fmt.Sprintf("%s played %s in %s", name, role, title))
+			fmt.Sprintf("%s played %s in %s", row.Name, row.Role, row.Title))
 	}
 
 	fmt.Println("Roles (transformed):")
@@ -116,6 +142,12 @@ func customTransformer(ctx context.Context, driver neo4j.DriverWithContext) {
 	}
 }
 
+// greetingRow is a canned greeting plus a node count.
+type greetingRow struct {
+	Greeting  string `neo4j:"greeting"`
+	NodeCount int64  `neo4j:"nodeCount"`
+}
+
 // Example 4: Specifying database
 func querySpecificDatabase(ctx context.Context, driver neo4j.DriverWithContext) {
 	fmt.Println("\n=== Example 4: Query Specific Database ===")
@@ -134,13 +166,17 @@ func querySpecificDatabase(ctx context.Context, driver neo4j.DriverWithContext)
 		return
 	}
 
-	if len(result.Records) > 0 {
-		greeting, _ := result.Records[0].Get("greeting")
-		count, _ := result.Records[0].Get("nodeCount")
-		fmt.Printf("%s (Total nodes: %v)\n", greeting, count)
+	if row, err := bind.ScanOne[greetingRow](result); err == nil {
+		fmt.Printf("%s (Total nodes: %v)\n", row.Greeting, row.NodeCount)
 	}
 }
 
+// titleRow is a single Movie title, returned by both the read and write
+// queries below.
+type titleRow struct {
+	Title string `neo4j:"title"`
+}
+
 // Example 5: Read vs Write routing
 func readWriteRouting(ctx context.Context, driver neo4j.DriverWithContext) {
 	fmt.Println("\n=== Example 5: Read/Write Routing ===")
@@ -166,9 +202,13 @@ func readWriteRouting(ctx context.Context, driver neo4j.DriverWithContext) {
 		return
 	}
 
-	for _, record := range readResult.Records {
-		title, _ := record.Get("title")
-		fmt.Printf("  - %s\n", title)
+	readRows, err := bind.ScanAll[titleRow](readResult)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	for _, row := range readRows {
+		fmt.Printf("  - %s\n", row.Title)
 	}
 
 	// Write query (default behavior - goes to leader)
@@ -194,9 +234,8 @@ func readWriteRouting(ctx context.Context, driver neo4j.DriverWithContext) {
 		return
 	}
 
-	if len(writeResult.Records) > 0 {
-		title, _ := writeResult.Records[0].Get("title")
-		fmt.Printf("  Created: %s\n", title)
+	if row, err := bind.ScanOne[titleRow](writeResult); err == nil {
+		fmt.Printf("  Created: %s\n", row.Title)
 	}
 
 	// Cleanup - delete the test movie
@@ -207,6 +246,15 @@ func readWriteRouting(ctx context.Context, driver neo4j.DriverWithContext) {
 	)
 }
 
+// movieDetailRow's Tagline is `any` rather than string because the column
+// can be null - bindRecord leaves untouched fields at their zero value, and
+// nil is a valid zero value for an interface but not for a string.
+type movieDetailRow struct {
+	Title   string `neo4j:"title"`
+	Year    int64  `neo4j:"year"`
+	Tagline any    `neo4j:"tagline"`
+}
+
 // Example 6: Accessing different data types
 func accessDataTypes(ctx context.Context, driver neo4j.DriverWithContext) {
 	fmt.Println("\n=== Example 6: Accessing Different Data Types ===")
@@ -231,22 +279,27 @@ func accessDataTypes(ctx context.Context, driver neo4j.DriverWithContext) {
 		return
 	}
 
-	for _, record := range result.Records {
-		title, ok1 := record.Get("title")
-		year, ok2 := record.Get("year")
-		tagline, ok3 := record.Get("tagline")
-
-		if ok1 && ok2 {
-			fmt.Printf("\nMovie: %s (%v)\n", title, year)
-			if ok3 && tagline != nil {
-				fmt.Printf("  Tagline: %s\n", tagline)
-			} else {
-				fmt.Printf("  Tagline: (none)\n")
-			}
+	rows, err := bind.ScanAll[movieDetailRow](result)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	for _, row := range rows {
+		fmt.Printf("\nMovie: %s (%v)\n", row.Title, row.Year)
+		if row.Tagline != nil {
+			fmt.Printf("  Tagline: %s\n", row.Tagline)
+		} else {
+			fmt.Printf("  Tagline: (none)\n")
 		}
 	}
 }
 
+// numberRow is a single scalar integer column.
+type numberRow struct {
+	Number int64 `neo4j:"number"`
+}
+
 // Example 7: Error handling
 func errorHandling(ctx context.Context, driver neo4j.DriverWithContext) {
 	fmt.Println("\n=== Example 7: Error Handling ===")
@@ -276,32 +329,19 @@ func errorHandling(ctx context.Context, driver neo4j.DriverWithContext) {
 
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
-	} else {
-		if len(result.Records) > 0 {
-			number, _ := result.Records[0].Get("number")
-			fmt.Printf("✓ Valid query executed: %v\n", number)
-		}
+	} else if row, err := bind.ScanOne[numberRow](result); err == nil {
+		fmt.Printf("✓ Valid query executed: %v\n", row.Number)
 	}
 }
 
 func runCypherExamples() {
 	// Setup driver
-	driver, err := neo4j.NewDriverWithContext(
-		"neo4j://localhost:7687",
-		neo4j.BasicAuth("neo4j", "Your@Password!@#", ""),
-	)
-	if err != nil {
-		panic(err)
-	}
-	defer driver.Close(context.Background())
-
 	ctx := context.Background()
-
-	// Verify connection
-	err = driver.VerifyConnectivity(ctx)
+	driver, err := config.DriverFromEnv(ctx)
 	if err != nil {
-		panic(fmt.Sprintf("Failed to connect: %v", err))
+		panic(err)
 	}
+	defer driver.Close(ctx)
 
 	fmt.Println("Connected to Neo4j successfully!")
 	fmt.Println("========================================")
@@ -0,0 +1,277 @@
+// Package cypherfix validates (and where possible repairs) the relationship
+// direction of Cypher patterns against the live database schema. The
+// canonical use case is LLM-generated Cypher that writes
+// "(m:Movie)-[:ACTED_IN]->(p:Person)" when the schema only has
+// "(:Person)-[:ACTED_IN]->(:Movie)" - this package flips the arrow back.
+//
+// The schema is introspected with CALL db.schema.visualization() and cached
+// for a TTL so repeated validations don't hit the database every time.
+//
+// This is a best-effort, regex-based pass rather than a full Cypher parser:
+// it handles the linear MATCH/MERGE patterns used throughout this example
+// set (including variable-length relationships and path variables), but
+// does not attempt to parse pattern comprehensions or deeply nested
+// property maps. String literals and comments are never touched because
+// only the arrowheads of a recognised pattern are rewritten.
+package cypherfix
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Diag describes one pattern edge the validator inspected.
+type Diag struct {
+	// Pattern is the node-rel-node fragment as it appeared in the query.
+	Pattern string
+	// Message explains what happened: left as-is, flipped, or unresolved.
+	Message string
+}
+
+type triple struct {
+	start, rel, end string
+}
+
+// Validator caches a schema snapshot and checks/corrects Cypher against it.
+type Validator struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	triples   map[triple]struct{}
+	fetchedAt time.Time
+}
+
+// NewValidator returns a Validator whose schema snapshot is refreshed at
+// most once per ttl.
+func NewValidator(ttl time.Duration) *Validator {
+	return &Validator{ttl: ttl}
+}
+
+var defaultValidator = NewValidator(5 * time.Minute)
+
+// Validate checks query against the live schema reachable through driver,
+// flipping relationship arrows that contradict it, using a shared default
+// validator with a 5 minute schema TTL. Most callers should use this; build
+// a dedicated Validator for a custom TTL.
+func Validate(ctx context.Context, driver neo4j.DriverWithContext, query string) (string, []Diag, error) {
+	return defaultValidator.Validate(ctx, driver, query)
+}
+
+// Validate checks query against the live schema reachable through driver,
+// flipping relationship arrows that contradict it.
+func (v *Validator) Validate(ctx context.Context, driver neo4j.DriverWithContext, query string) (string, []Diag, error) {
+	if err := v.refreshSchema(ctx, driver); err != nil {
+		return query, nil, fmt.Errorf("cypherfix: refresh schema: %w", err)
+	}
+
+	labels := resolveVarLabels(query)
+	fixed, diags := v.fixArrows(query, labels)
+	return fixed, diags, nil
+}
+
+func (v *Validator) refreshSchema(ctx context.Context, driver neo4j.DriverWithContext) error {
+	v.mu.Lock()
+	stale := time.Since(v.fetchedAt) > v.ttl || v.triples == nil
+	v.mu.Unlock()
+	if !stale {
+		return nil
+	}
+
+	triples, err := fetchSchemaTriples(ctx, driver)
+	if err != nil {
+		return err
+	}
+
+	v.mu.Lock()
+	v.triples = triples
+	v.fetchedAt = time.Now()
+	v.mu.Unlock()
+	return nil
+}
+
+func fetchSchemaTriples(ctx context.Context, driver neo4j.DriverWithContext) (map[triple]struct{}, error) {
+	result, err := neo4j.ExecuteQuery(ctx, driver,
+		"CALL db.schema.visualization()",
+		nil,
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	triples := map[triple]struct{}{}
+	for _, record := range result.Records {
+		rawNodes, _ := record.Get("nodes")
+		rawRels, _ := record.Get("relationships")
+
+		nodeLabel := map[string]string{}
+		if nodes, ok := rawNodes.([]any); ok {
+			for _, n := range nodes {
+				if node, ok := n.(neo4j.Node); ok && len(node.Labels) > 0 {
+					nodeLabel[node.ElementId] = node.Labels[0]
+				}
+			}
+		}
+
+		if rels, ok := rawRels.([]any); ok {
+			for _, r := range rels {
+				rel, ok := r.(neo4j.Relationship)
+				if !ok {
+					continue
+				}
+				start, sok := nodeLabel[rel.StartElementId]
+				end, eok := nodeLabel[rel.EndElementId]
+				if sok && eok {
+					triples[triple{start: start, rel: rel.Type, end: end}] = struct{}{}
+				}
+			}
+		}
+	}
+	return triples, nil
+}
+
+func (v *Validator) has(start, rel, end string) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	_, ok := v.triples[triple{start: start, rel: rel, end: end}]
+	return ok
+}
+
+var nodeRe = regexp.MustCompile(`\(\s*([A-Za-z_]\w*)?((?::[A-Za-z_]\w*)*)\s*(?:\{[^{}]*\})?\s*\)`)
+
+// resolveVarLabels makes a single left-to-right pass over the whole query
+// to learn each pattern variable's first-declared label, so that a bare
+// "(p)" reference later in the query can still be checked.
+func resolveVarLabels(query string) map[string]string {
+	labels := map[string]string{}
+	for _, m := range nodeRe.FindAllStringSubmatch(query, -1) {
+		variable, labelList := m[1], m[2]
+		if variable == "" || labelList == "" {
+			continue
+		}
+		if _, known := labels[variable]; !known {
+			labels[variable] = strings.SplitN(strings.TrimPrefix(labelList, ":"), ":", 2)[0]
+		}
+	}
+	return labels
+}
+
+// edgeOnlyRe matches just the relationship portion between two nodes
+// ("-[:ACTED_IN]->", "<-[r:ACTED_IN*1..3]-", etc.), anchored to the whole
+// gap between one node's closing paren and the next node's opening paren.
+// Matching the relationship on its own, rather than a whole
+// node-rel-node triple, is what lets fixArrows walk a chained pattern like
+// "(a)-[r1]->(b)-[r2]->(c)": (b) is then just the end of one gap and the
+// start of the next, instead of being consumed by one non-overlapping
+// regexp.FindAll match and made unavailable to the other.
+var edgeOnlyRe = regexp.MustCompile(
+	`^\s*(<)?-\s*\[\s*[A-Za-z_]?\w*\s*((?::[A-Za-z_]\w*(?:\|[A-Za-z_]\w*)*)?)\s*(\*[^\]]*)?\s*(?:\{[^{}]*\})?\]\s*-\s*(>)?\s*$`,
+)
+
+// fixArrows scans query for node-rel-node triples and flips any arrow that
+// contradicts the schema. It walks node occurrences left-to-right and, for
+// each pair of consecutive nodes, checks whether the text between them is a
+// single relationship - if so the second node's label becomes the next
+// gap's start, so chained patterns are inspected edge by edge rather than
+// only every other edge. Text outside a matched edge (including whatever
+// follows a variable-length relationship's "*..." marker) is left untouched.
+func (v *Validator) fixArrows(query string, labels map[string]string) (string, []Diag) {
+	var diags []Diag
+	var out strings.Builder
+	last := 0
+
+	nodes := nodeRe.FindAllStringSubmatchIndex(query, -1)
+	for i := 0; i+1 < len(nodes); i++ {
+		start, end := submatches(query, nodes[i]), submatches(query, nodes[i+1])
+		gapStart, gapEnd := nodes[i][1], nodes[i+1][0]
+		gap := query[gapStart:gapEnd]
+
+		m := edgeOnlyRe.FindStringSubmatch(gap)
+		if m == nil {
+			continue // not a direct edge between these two nodes
+		}
+		leftArrow, relTypes, varLength, rightArrow := m[1], m[2], m[3], m[4]
+
+		startLabel := firstLabel(start[2], start[1], labels)
+		endLabel := firstLabel(end[2], end[1], labels)
+		pattern := query[nodes[i][0]:nodes[i+1][1]]
+
+		out.WriteString(query[last:gapStart])
+		last = gapEnd
+
+		switch {
+		case leftArrow == "" && rightArrow == "":
+			out.WriteString(gap) // undirected pattern, nothing to flip
+		case strings.Contains(relTypes, "|"):
+			diags = append(diags, Diag{Pattern: pattern, Message: "multiple relationship types, skipped"})
+			out.WriteString(gap)
+		case startLabel == "" || endLabel == "":
+			diags = append(diags, Diag{Pattern: pattern, Message: "could not resolve a label for one of the nodes, skipped"})
+			out.WriteString(gap)
+		default:
+			relType := strings.TrimPrefix(relTypes, ":")
+			forward := v.has(startLabel, relType, endLabel)
+			backward := v.has(endLabel, relType, startLabel)
+			switch {
+			case forward || (!forward && !backward):
+				if !forward && !backward {
+					diags = append(diags, Diag{Pattern: pattern, Message: fmt.Sprintf("no (%s)-[:%s]->(%s) relationship in schema either direction", startLabel, relType, endLabel)})
+				}
+				out.WriteString(gap)
+			default: // only the reverse direction exists: flip it
+				out.WriteString(flipArrow(gap, leftArrow != ""))
+				diags = append(diags, Diag{Pattern: pattern, Message: fmt.Sprintf("flipped to match (%s)-[:%s]->(%s) in schema", endLabel, relType, startLabel)})
+			}
+		}
+		_ = varLength
+	}
+
+	out.WriteString(query[last:])
+	return out.String(), diags
+}
+
+func firstLabel(labelList, variable string, known map[string]string) string {
+	if labelList != "" {
+		return strings.SplitN(strings.TrimPrefix(labelList, ":"), ":", 2)[0]
+	}
+	return known[variable]
+}
+
+// flipArrow swaps a matched edge's arrowheads in place: "<-[...]-" becomes
+// "-[...]->"" and vice versa, leaving everything between the brackets as-is.
+func flipArrow(pattern string, wasLeft bool) string {
+	open := strings.IndexByte(pattern, '[')
+	shut := strings.LastIndexByte(pattern, ']')
+	if open < 0 || shut < 0 || shut < open {
+		return pattern
+	}
+	before := pattern[:open]
+	middle := pattern[open : shut+1]
+	after := pattern[shut+1:]
+
+	if wasLeft {
+		before = strings.Replace(before, "<-", "-", 1)
+		after = strings.Replace(after, "-", "->", 1)
+	} else {
+		before = strings.Replace(before, "-", "<-", 1)
+		after = strings.Replace(after, "->", "-", 1)
+	}
+	return before + middle + after
+}
+
+func submatches(s string, idx []int) []string {
+	out := make([]string, len(idx)/2)
+	for i := range out {
+		if idx[2*i] < 0 {
+			continue
+		}
+		out[i] = s[idx[2*i]:idx[2*i+1]]
+	}
+	return out
+}
@@ -0,0 +1,42 @@
+package cypherfix
+
+import "testing"
+
+func validatorWithSchema(triples ...triple) *Validator {
+	v := &Validator{triples: map[triple]struct{}{}}
+	for _, t := range triples {
+		v.triples[t] = struct{}{}
+	}
+	return v
+}
+
+func TestFixArrowsChainedPattern(t *testing.T) {
+	v := validatorWithSchema(triple{start: "Person", rel: "ACTED_IN", end: "Movie"})
+
+	query := "MATCH (tom:Person)-[:ACTED_IN]->(m:Movie)<-[:ACTED_IN]-(costar:Person) RETURN costar"
+	labels := resolveVarLabels(query)
+	fixed, diags := v.fixArrows(query, labels)
+
+	want := "MATCH (tom:Person)-[:ACTED_IN]->(m:Movie)-[:ACTED_IN]->(costar:Person) RETURN costar"
+	if fixed != want {
+		t.Errorf("fixArrows chain = %q, want %q", fixed, want)
+	}
+	if len(diags) != 1 {
+		t.Fatalf("diags = %v, want exactly one (the flipped second edge)", diags)
+	}
+}
+
+func TestFixArrowsDoesNotBridgeSeparateMatchClauses(t *testing.T) {
+	v := validatorWithSchema(triple{start: "Person", rel: "ACTED_IN", end: "Movie"})
+
+	query := "MATCH (tom:Person)-[:ACTED_IN]->(m:Movie) MATCH (costar:Person)-[:ACTED_IN]->(m2:Movie) RETURN tom"
+	labels := resolveVarLabels(query)
+	fixed, diags := v.fixArrows(query, labels)
+
+	if fixed != query {
+		t.Errorf("fixArrows across separate MATCH clauses = %q, want unchanged %q", fixed, query)
+	}
+	if len(diags) != 0 {
+		t.Errorf("diags = %v, want none (the gap between clauses, which includes the MATCH keyword, is not a relationship)", diags)
+	}
+}
@@ -0,0 +1,346 @@
+// Package cypherq is a small, chainable Cypher query builder. It exists so
+// callers don't have to hand-concatenate Cypher strings the way setupSampleData
+// does for its MERGE blocks. A built query's (cypher, params) pair can be
+// passed straight to neo4j.ExecuteQuery.
+package cypherq
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Direction controls which way a relationship arrow points in a pattern.
+type Direction int
+
+const (
+	// Out renders "-[...]->".
+	Out Direction = iota
+	// In renders "<-[...]-".
+	In
+	// Either renders the undirected "-[...]-".
+	Either
+)
+
+// ParamRef is a named query parameter placeholder created by Param. Using it
+// inside a pattern or clause both renders "$name" in the Cypher text and
+// registers name -> value in the query's params map.
+type ParamRef struct {
+	name  string
+	value any
+}
+
+// Param creates a named parameter bound to value.
+func Param(name string, value any) ParamRef {
+	return ParamRef{name: name, value: value}
+}
+
+// NodePattern is a single "(var:Label {props})" pattern segment.
+type NodePattern struct {
+	variable string
+	labels   []string
+	props    []propPair
+}
+
+type propPair struct {
+	key   string
+	value any
+}
+
+// Node starts a new node pattern, e.g. Node("tom", "Person").
+func Node(variable string, labels ...string) *NodePattern {
+	return &NodePattern{variable: variable, labels: labels}
+}
+
+// Props attaches inline properties as alternating key/value pairs, e.g.
+// Props("name", Param("name"), "born", Param("born")).
+func (n *NodePattern) Props(kvs ...any) *NodePattern {
+	for i := 0; i+1 < len(kvs); i += 2 {
+		key, _ := kvs[i].(string)
+		n.props = append(n.props, propPair{key: key, value: kvs[i+1]})
+	}
+	return n
+}
+
+func (n *NodePattern) render(params map[string]any) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	b.WriteString(n.variable)
+	for _, l := range n.labels {
+		b.WriteByte(':')
+		b.WriteString(l)
+	}
+	writeProps(&b, n.props, params)
+	b.WriteByte(')')
+	return b.String()
+}
+
+func writeProps(b *strings.Builder, props []propPair, params map[string]any) {
+	if len(props) == 0 {
+		return
+	}
+	b.WriteString(" {")
+	for i, p := range props {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(p.key)
+		b.WriteString(": ")
+		b.WriteString(renderValue(p.value, params))
+	}
+	b.WriteString("}")
+}
+
+func renderValue(v any, params map[string]any) string {
+	if ref, ok := v.(ParamRef); ok {
+		params[ref.name] = ref.value
+		return "$" + ref.name
+	}
+	switch val := v.(type) {
+	case string:
+		return strconv.Quote(val)
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
+
+func renderRel(typeExpr string, dir Direction) string {
+	rel := "[:" + typeExpr + "]"
+	if strings.Contains(typeExpr, ":") {
+		rel = "[" + typeExpr + "]"
+	}
+	switch dir {
+	case Out:
+		return "-" + rel + "->"
+	case In:
+		return "<-" + rel + "-"
+	default:
+		return "-" + rel + "-"
+	}
+}
+
+// Query accumulates clauses for a single Cypher statement. Build a new one
+// with Match, Merge or Create.
+type Query struct {
+	params map[string]any
+
+	matchClauses  []string
+	whereClauses  []string
+	withClauses   []string
+	unwindClauses []string
+	setClauses    []string
+	deleteClauses []string
+	returnClauses []string
+	distinct      bool
+	orderBy       []string
+	limit         *int
+
+	pathVar  string
+	segments []string
+}
+
+func newQuery() *Query {
+	return &Query{params: map[string]any{}}
+}
+
+// Match starts a MATCH clause with an opening node pattern.
+func Match(n *NodePattern) *Query {
+	q := newQuery()
+	q.segments = append(q.segments, n.render(q.params))
+	return q
+}
+
+// MatchPath starts a MATCH clause that binds the whole pattern to pathVar,
+// e.g. "MATCH path = (p)-[r]->(m)" as used by handlePaths.
+func MatchPath(pathVar string, n *NodePattern) *Query {
+	q := Match(n)
+	q.pathVar = pathVar
+	return q
+}
+
+// Merge starts a MERGE clause with an opening node pattern.
+func Merge(n *NodePattern) *Query {
+	q := newQuery()
+	q.segments = append(q.segments, n.render(q.params))
+	q.pathVar = mergeMarker
+	return q
+}
+
+// Create starts a CREATE clause with an opening node pattern.
+func Create(n *NodePattern) *Query {
+	q := newQuery()
+	q.segments = append(q.segments, n.render(q.params))
+	q.pathVar = createMarker
+	return q
+}
+
+// markers distinguish Merge/Create from a plain (unnamed) Match when the
+// pattern is flushed, without adding another field to Query.
+const (
+	mergeMarker  = "\x00merge"
+	createMarker = "\x00create"
+)
+
+// Rel appends a relationship segment to the pattern currently being built,
+// e.g. Rel(":ACTED_IN", cypherq.Out).
+func (q *Query) Rel(typeExpr string, dir Direction) *Query {
+	q.segments = append(q.segments, renderRel(typeExpr, dir))
+	return q
+}
+
+// Node appends a node segment to the pattern currently being built.
+func (q *Query) Node(variable string, labels ...string) *Query {
+	q.segments = append(q.segments, Node(variable, labels...).render(q.params))
+	return q
+}
+
+// PropsOn attaches inline properties to the most recently appended node
+// segment, e.g. q.Node("m", "Movie").PropsOn("title", Param("title")).
+func (q *Query) PropsOn(kvs ...any) *Query {
+	if len(q.segments) == 0 {
+		return q
+	}
+	last := len(q.segments) - 1
+	closing := strings.LastIndexByte(q.segments[last], ')')
+	if closing < 0 {
+		return q
+	}
+	var b strings.Builder
+	var props []propPair
+	for i := 0; i+1 < len(kvs); i += 2 {
+		key, _ := kvs[i].(string)
+		props = append(props, propPair{key: key, value: kvs[i+1]})
+	}
+	writeProps(&b, props, q.params)
+	q.segments[last] = q.segments[last][:closing] + b.String() + q.segments[last][closing:]
+	return q
+}
+
+func (q *Query) flushPattern() {
+	if len(q.segments) == 0 {
+		return
+	}
+	pattern := strings.Join(q.segments, "")
+	switch q.pathVar {
+	case mergeMarker:
+		q.matchClauses = append(q.matchClauses, "MERGE "+pattern)
+	case createMarker:
+		q.matchClauses = append(q.matchClauses, "CREATE "+pattern)
+	case "":
+		q.matchClauses = append(q.matchClauses, "MATCH "+pattern)
+	default:
+		q.matchClauses = append(q.matchClauses, fmt.Sprintf("MATCH %s = %s", q.pathVar, pattern))
+	}
+	q.segments = nil
+	q.pathVar = ""
+}
+
+// Where adds a WHERE condition. Any ParamRef passed in args is registered
+// into the query's params map; reference it in cond as "$name".
+func (q *Query) Where(cond string, args ...ParamRef) *Query {
+	q.flushPattern()
+	for _, a := range args {
+		q.params[a.name] = a.value
+	}
+	q.whereClauses = append(q.whereClauses, cond)
+	return q
+}
+
+// With adds a WITH clause.
+func (q *Query) With(cols ...string) *Query {
+	q.flushPattern()
+	q.withClauses = append(q.withClauses, strings.Join(cols, ", "))
+	return q
+}
+
+// Unwind adds an "UNWIND expr AS alias" clause.
+func (q *Query) Unwind(expr, alias string) *Query {
+	q.flushPattern()
+	q.unwindClauses = append(q.unwindClauses, fmt.Sprintf("UNWIND %s AS %s", expr, alias))
+	return q
+}
+
+// Set adds one or more "SET" assignments, e.g. Set("m.seen = true").
+func (q *Query) Set(assignments ...string) *Query {
+	q.flushPattern()
+	q.setClauses = append(q.setClauses, assignments...)
+	return q
+}
+
+// Delete adds a DELETE clause over the given variables.
+func (q *Query) Delete(vars ...string) *Query {
+	q.flushPattern()
+	q.deleteClauses = append(q.deleteClauses, "DELETE "+strings.Join(vars, ", "))
+	return q
+}
+
+// DetachDelete adds a DETACH DELETE clause over the given variables.
+func (q *Query) DetachDelete(vars ...string) *Query {
+	q.flushPattern()
+	q.deleteClauses = append(q.deleteClauses, "DETACH DELETE "+strings.Join(vars, ", "))
+	return q
+}
+
+// Return adds a RETURN clause.
+func (q *Query) Return(cols ...string) *Query {
+	q.flushPattern()
+	q.returnClauses = cols
+	return q
+}
+
+// ReturnDistinct adds a "RETURN DISTINCT" clause.
+func (q *Query) ReturnDistinct(cols ...string) *Query {
+	q.flushPattern()
+	q.distinct = true
+	q.returnClauses = cols
+	return q
+}
+
+// OrderBy adds an ORDER BY clause.
+func (q *Query) OrderBy(cols ...string) *Query {
+	q.orderBy = cols
+	return q
+}
+
+// Limit adds a LIMIT clause.
+func (q *Query) Limit(n int) *Query {
+	q.limit = &n
+	return q
+}
+
+// Build renders the accumulated clauses into Cypher text and returns the
+// parameter map collected along the way, ready for neo4j.ExecuteQuery.
+func (q *Query) Build() (string, map[string]any) {
+	q.flushPattern()
+
+	var lines []string
+	lines = append(lines, q.matchClauses...)
+	for _, w := range q.withClauses {
+		lines = append(lines, "WITH "+w)
+	}
+	for _, u := range q.unwindClauses {
+		lines = append(lines, u)
+	}
+	if len(q.whereClauses) > 0 {
+		lines = append(lines, "WHERE "+strings.Join(q.whereClauses, " AND "))
+	}
+	if len(q.setClauses) > 0 {
+		lines = append(lines, "SET "+strings.Join(q.setClauses, ", "))
+	}
+	lines = append(lines, q.deleteClauses...)
+	if len(q.returnClauses) > 0 {
+		keyword := "RETURN "
+		if q.distinct {
+			keyword = "RETURN DISTINCT "
+		}
+		lines = append(lines, keyword+strings.Join(q.returnClauses, ", "))
+	}
+	if len(q.orderBy) > 0 {
+		lines = append(lines, "ORDER BY "+strings.Join(q.orderBy, ", "))
+	}
+	if q.limit != nil {
+		lines = append(lines, fmt.Sprintf("LIMIT %d", *q.limit))
+	}
+
+	return strings.Join(lines, "\n"), q.params
+}
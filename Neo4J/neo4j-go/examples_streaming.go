@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/rupeedev/ai-pack/neo4j-go/internal/config"
+)
+
+// cursorStreamingExample walks a result one record at a time via
+// result.Next(ctx), instead of loading every row into memory the way
+// neo4j.EagerResultTransformer does. It stops after a handful of rows to show
+// that the driver doesn't keep pulling records the caller never asked for.
+func cursorStreamingExample(ctx context.Context, driver neo4j.DriverWithContext) {
+	fmt.Println("\n=== Example 1: Cursor-Based Streaming (result.Next) ===")
+
+	session := driver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+
+	const stopAfter = 3
+	seen := 0
+
+	_, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, "MATCH (m:Movie) RETURN m.title AS title ORDER BY m.title", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for result.Next(ctx) {
+			title, _ := result.Record().Get("title")
+			seen++
+			fmt.Printf("  [%d] %v\n", seen, title)
+
+			if seen == stopAfter {
+				fmt.Println("  ...stopping early, remaining rows are never fetched")
+				break
+			}
+		}
+		if err := result.Err(); err != nil {
+			return nil, err
+		}
+
+		if _, err := result.Consume(ctx); err != nil {
+			return nil, err
+		}
+		fmt.Printf("✓ Stopped after %d row(s); remaining rows were never streamed over the wire\n", seen)
+		return nil, nil
+	})
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+}
+
+// rowCallback is invoked once per record streamed through
+// streamingTransformer, in order, without the transformer buffering any rows
+// itself. Returning false stops iteration early, before Complete is called.
+type rowCallback func(title string) (keepGoing bool)
+
+// streamingTransformer is a neo4j.ResultTransformer that forwards each record
+// straight to a caller-supplied callback instead of accumulating rows, the
+// way neo4j.EagerResultTransformer or bind.StructResultTransformer do.
+type streamingTransformer struct {
+	onRow   rowCallback
+	stopped bool
+	rows    int
+}
+
+func newStreamingTransformer(onRow rowCallback) func() neo4j.ResultTransformer[int] {
+	return func() neo4j.ResultTransformer[int] {
+		return &streamingTransformer{onRow: onRow}
+	}
+}
+
+func (s *streamingTransformer) Accept(record *neo4j.Record) error {
+	if s.stopped {
+		return nil
+	}
+	title, _ := record.Get("title")
+	s.rows++
+	if !s.onRow(fmt.Sprintf("%v", title)) {
+		s.stopped = true
+	}
+	return nil
+}
+
+func (s *streamingTransformer) Complete([]string, neo4j.ResultSummary) (int, error) {
+	return s.rows, nil
+}
+
+// callbackStreamingExample demonstrates a custom ResultTransformer that
+// streams records through a callback instead of buffering them, then
+// contrasts it with controlling how many rows the driver buffers per
+// network round trip via neo4j.SessionConfig.FetchSize - ExecuteQuery itself
+// has no fetch-size option, only Routing/Database/BookmarkManager/etc.
+func callbackStreamingExample(ctx context.Context, driver neo4j.DriverWithContext) {
+	fmt.Println("\n=== Example 2: Custom Streaming Transformer + Fetch Size ===")
+
+	delivered := 0
+	onRow := func(title string) bool {
+		delivered++
+		fmt.Printf("  callback got: %s\n", title)
+		return delivered < 3 // stop after 3 rows
+	}
+
+	rows, err := neo4j.ExecuteQuery(ctx, driver,
+		"MATCH (m:Movie) RETURN m.title AS title ORDER BY m.title",
+		nil,
+		newStreamingTransformer(onRow),
+	)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Printf("✓ Transformer saw %d row(s) before stopping\n", rows)
+
+	fetchSizeExample(ctx, driver, 2)
+	fetchSizeExample(ctx, driver, 500)
+}
+
+// fetchSizeExample runs the same query through a session opened with an
+// explicit SessionConfig.FetchSize, showing that it's a session-level
+// setting rather than something ExecuteQuery's own options expose.
+func fetchSizeExample(ctx context.Context, driver neo4j.DriverWithContext, fetchSize int) {
+	session := driver.NewSession(ctx, neo4j.SessionConfig{FetchSize: fetchSize})
+	defer session.Close(ctx)
+
+	rows := 0
+	_, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, "MATCH (m:Movie) RETURN m.title AS title ORDER BY m.title", nil)
+		if err != nil {
+			return nil, err
+		}
+		for result.Next(ctx) {
+			rows++
+		}
+		return nil, result.Err()
+	})
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Printf("✓ Session with FetchSize=%d pulled %d row(s) total\n", fetchSize, rows)
+}
+
+// earlyTerminationExample shows that breaking out of a result.Next loop, or
+// returning false from a streaming callback, is not an error - it's the
+// normal way to bound work against a result set that could be huge.
+func earlyTerminationExample(ctx context.Context, driver neo4j.DriverWithContext) {
+	fmt.Println("\n=== Example 3: Early Termination Is Not An Error ===")
+
+	session := driver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+
+	_, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, "MATCH (m:Movie) RETURN m.title AS title", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if !result.Next(ctx) {
+			return nil, errors.New("expected at least one movie")
+		}
+		title, _ := result.Record().Get("title")
+		fmt.Printf("✓ Took just the first row (%v) and moved on\n", title)
+
+		// Consuming early, rather than draining result.Next, still lets the
+		// driver reuse the underlying connection for the next query.
+		_, err = result.Consume(ctx)
+		return nil, err
+	})
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+}
+
+func runStreamingExamples() {
+	ctx := context.Background()
+	driver, err := config.DriverFromEnv(ctx)
+	if err != nil {
+		panic(err)
+	}
+	defer driver.Close(ctx)
+
+	fmt.Println("Connected to Neo4j successfully!")
+	fmt.Println("========================================")
+
+	cursorStreamingExample(ctx, driver)
+	callbackStreamingExample(ctx, driver)
+	earlyTerminationExample(ctx, driver)
+
+	fmt.Println("\n========================================")
+	fmt.Println("All streaming examples completed!")
+}
@@ -0,0 +1,280 @@
+// Package gds wraps the most commonly reached-for Neo4j Graph Data Science
+// and APOC procedures (graph projection, PageRank, Louvain community
+// detection, Dijkstra shortest path, and apoc.path expansion) behind a small
+// Go API, so callers don't have to hand-write the CALL ... YIELD boilerplate
+// for every algorithm run.
+//
+// It assumes the target database has the GDS and APOC plugins installed;
+// calls simply fail with the server's error if they aren't.
+package gds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Graph is an in-memory GDS graph projection, identified by name.
+type Graph struct {
+	Name string
+}
+
+// ProjectNative creates an in-memory graph projection over the given node
+// labels and relationship types via gds.graph.project.
+func ProjectNative(ctx context.Context, driver neo4j.DriverWithContext, name string, nodeLabels, relTypes []string) (Graph, error) {
+	_, err := neo4j.ExecuteQuery(ctx, driver,
+		"CALL gds.graph.project($name, $nodeLabels, $relTypes) YIELD graphName",
+		map[string]any{"name": name, "nodeLabels": nodeLabels, "relTypes": relTypes},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return Graph{}, fmt.Errorf("gds: project %s: %w", name, err)
+	}
+	return Graph{Name: name}, nil
+}
+
+// ProjectCypher creates an in-memory graph projection via
+// gds.graph.project.cypher, for callers whose node/relationship selection
+// isn't a plain label/type list (e.g. it needs a WHERE clause or computed
+// properties). nodeQuery and relQuery are the two Cypher fragments GDS
+// expects, e.g. "MATCH (n) RETURN id(n) AS id" and
+// "MATCH (a)-[r]->(b) RETURN id(a) AS source, id(b) AS target".
+func ProjectCypher(ctx context.Context, driver neo4j.DriverWithContext, name, nodeQuery, relQuery string) (Graph, error) {
+	_, err := neo4j.ExecuteQuery(ctx, driver,
+		"CALL gds.graph.project.cypher($name, $nodeQuery, $relQuery) YIELD graphName",
+		map[string]any{"name": name, "nodeQuery": nodeQuery, "relQuery": relQuery},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return Graph{}, fmt.Errorf("gds: project cypher %s: %w", name, err)
+	}
+	return Graph{Name: name}, nil
+}
+
+// HasProcedurePrefix reports whether any installed procedure's name starts
+// with prefix (e.g. "gds." or "apoc."), so callers can detect which plugins
+// the connected server has available.
+func HasProcedurePrefix(ctx context.Context, driver neo4j.DriverWithContext, prefix string) (bool, error) {
+	result, err := neo4j.ExecuteQuery(ctx, driver,
+		"SHOW PROCEDURES YIELD name WHERE name STARTS WITH $prefix RETURN count(*) AS count",
+		map[string]any{"prefix": prefix},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return false, fmt.Errorf("gds: show procedures %s: %w", prefix, err)
+	}
+	if len(result.Records) == 0 {
+		return false, nil
+	}
+	count, _ := result.Records[0].Get("count")
+	n, _ := count.(int64)
+	return n > 0, nil
+}
+
+// Exists reports whether a projection with this name is currently loaded.
+func (g Graph) Exists(ctx context.Context, driver neo4j.DriverWithContext) (bool, error) {
+	result, err := neo4j.ExecuteQuery(ctx, driver,
+		"CALL gds.graph.exists($name) YIELD exists RETURN exists",
+		map[string]any{"name": g.Name},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return false, fmt.Errorf("gds: exists %s: %w", g.Name, err)
+	}
+	if len(result.Records) == 0 {
+		return false, nil
+	}
+	exists, _ := result.Records[0].Get("exists")
+	b, _ := exists.(bool)
+	return b, nil
+}
+
+// Drop releases the in-memory projection.
+func (g Graph) Drop(ctx context.Context, driver neo4j.DriverWithContext) error {
+	_, err := neo4j.ExecuteQuery(ctx, driver,
+		"CALL gds.graph.drop($name)",
+		map[string]any{"name": g.Name},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return fmt.Errorf("gds: drop %s: %w", g.Name, err)
+	}
+	return nil
+}
+
+// PageRankOptions configures a gds.pageRank.stream run.
+type PageRankOptions struct {
+	MaxIterations int
+	DampingFactor float64
+}
+
+// PageRankResult is one row of a PageRank run, joined back to the real node.
+type PageRankResult struct {
+	Node  neo4j.Node
+	Score float64
+}
+
+// PageRank runs gds.pageRank.stream over the projection and returns every
+// node's score, highest first.
+func (g Graph) PageRank(ctx context.Context, driver neo4j.DriverWithContext, opts PageRankOptions) ([]PageRankResult, error) {
+	result, err := neo4j.ExecuteQuery(ctx, driver, `
+		CALL gds.pageRank.stream($name, {maxIterations: $maxIterations, dampingFactor: $dampingFactor})
+		YIELD nodeId, score
+		RETURN gds.util.asNode(nodeId) AS node, score
+		ORDER BY score DESC
+	`,
+		map[string]any{
+			"name":          g.Name,
+			"maxIterations": opts.MaxIterations,
+			"dampingFactor": opts.DampingFactor,
+		},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("gds: pageRank %s: %w", g.Name, err)
+	}
+
+	out := make([]PageRankResult, 0, len(result.Records))
+	for _, record := range result.Records {
+		nodeVal, _ := record.Get("node")
+		scoreVal, _ := record.Get("score")
+		node, _ := nodeVal.(neo4j.Node)
+		score, _ := scoreVal.(float64)
+		out = append(out, PageRankResult{Node: node, Score: score})
+	}
+	return out, nil
+}
+
+// LouvainOptions configures a gds.louvain.stream run.
+type LouvainOptions struct {
+	IncludeIntermediateCommunities bool
+}
+
+// CommunityResult is one row of a community-detection run.
+type CommunityResult struct {
+	Node        neo4j.Node
+	CommunityID int64
+}
+
+// Louvain runs gds.louvain.stream over the projection, assigning each node
+// a community id.
+func (g Graph) Louvain(ctx context.Context, driver neo4j.DriverWithContext, opts LouvainOptions) ([]CommunityResult, error) {
+	result, err := neo4j.ExecuteQuery(ctx, driver, `
+		CALL gds.louvain.stream($name, {includeIntermediateCommunities: $includeIntermediate})
+		YIELD nodeId, communityId
+		RETURN gds.util.asNode(nodeId) AS node, communityId
+		ORDER BY communityId
+	`,
+		map[string]any{"name": g.Name, "includeIntermediate": opts.IncludeIntermediateCommunities},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("gds: louvain %s: %w", g.Name, err)
+	}
+
+	out := make([]CommunityResult, 0, len(result.Records))
+	for _, record := range result.Records {
+		nodeVal, _ := record.Get("node")
+		communityVal, _ := record.Get("communityId")
+		node, _ := nodeVal.(neo4j.Node)
+		community, _ := communityVal.(int64)
+		out = append(out, CommunityResult{Node: node, CommunityID: community})
+	}
+	return out, nil
+}
+
+// ShortestPathResult is the outcome of a Dijkstra shortest-path run.
+type ShortestPathResult struct {
+	TotalCost float64
+	NodeIDs   []int64
+	Path      neo4j.Path
+}
+
+// ShortestPath runs gds.shortestPath.dijkstra.stream between sourceElementID
+// and targetElementID, weighted by relationshipWeightProperty.
+func (g Graph) ShortestPath(ctx context.Context, driver neo4j.DriverWithContext, sourceElementID, targetElementID, relationshipWeightProperty string) (ShortestPathResult, error) {
+	result, err := neo4j.ExecuteQuery(ctx, driver, `
+		MATCH (source), (target)
+		WHERE elementId(source) = $source AND elementId(target) = $target
+		CALL gds.shortestPath.dijkstra.stream($name, {
+			sourceNode: source,
+			targetNode: target,
+			relationshipWeightProperty: $weightProperty
+		})
+		YIELD totalCost, nodeIds, path
+		RETURN totalCost, nodeIds, path
+	`,
+		map[string]any{
+			"name":           g.Name,
+			"source":         sourceElementID,
+			"target":         targetElementID,
+			"weightProperty": relationshipWeightProperty,
+		},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return ShortestPathResult{}, fmt.Errorf("gds: shortestPath %s: %w", g.Name, err)
+	}
+	if len(result.Records) == 0 {
+		return ShortestPathResult{}, fmt.Errorf("gds: no path found between %s and %s", sourceElementID, targetElementID)
+	}
+
+	record := result.Records[0]
+	costVal, _ := record.Get("totalCost")
+	idsVal, _ := record.Get("nodeIds")
+	pathVal, _ := record.Get("path")
+
+	cost, _ := costVal.(float64)
+	path, _ := pathVal.(neo4j.Path)
+
+	var ids []int64
+	if raw, ok := idsVal.([]any); ok {
+		for _, v := range raw {
+			if id, ok := v.(int64); ok {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	return ShortestPathResult{TotalCost: cost, NodeIDs: ids, Path: path}, nil
+}
+
+// ExpandPaths wraps apoc.path.expandConfig to walk out from a single node
+// within minDepth..maxDepth hops, following relFilter (APOC's
+// "REL_TYPE>" / "<REL_TYPE" direction syntax) and labelFilter.
+func ExpandPaths(ctx context.Context, driver neo4j.DriverWithContext, startElementID, relFilter, labelFilter string, minDepth, maxDepth int) ([]neo4j.Path, error) {
+	result, err := neo4j.ExecuteQuery(ctx, driver, `
+		MATCH (start)
+		WHERE elementId(start) = $start
+		CALL apoc.path.expandConfig(start, {
+			relationshipFilter: $relFilter,
+			labelFilter: $labelFilter,
+			minLevel: $minDepth,
+			maxLevel: $maxDepth
+		})
+		YIELD path
+		RETURN path
+	`,
+		map[string]any{
+			"start":       startElementID,
+			"relFilter":   relFilter,
+			"labelFilter": labelFilter,
+			"minDepth":    minDepth,
+			"maxDepth":    maxDepth,
+		},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("gds: expandPaths from %s: %w", startElementID, err)
+	}
+
+	out := make([]neo4j.Path, 0, len(result.Records))
+	for _, record := range result.Records {
+		pathVal, _ := record.Get("path")
+		if path, ok := pathVal.(neo4j.Path); ok {
+			out = append(out, path)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,124 @@
+// Package geo wraps neo4j.Point2D/Point3D with the distance and
+// bounding-box math the spatial examples in this repo currently do by hand,
+// so callers don't have to re-derive the haversine formula or hand-roll a
+// WHERE-clause prefilter for every "nearby" query.
+package geo
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// earthRadiusMeters is the mean Earth radius used by Neo4j's own
+// point.distance for WGS-84 geographic points.
+const earthRadiusMeters = 6371008.8
+
+// Geographic SRIDs: 4326 is WGS-84 2D (latitude/longitude), 4979 is its 3D
+// form (latitude/longitude/height). Cartesian SRIDs: 7203 is 2D, 9157 is 3D.
+const (
+	sridWGS84_2D    = 4326
+	sridWGS84_3D    = 4979
+	sridCartesian2D = 7203
+	sridCartesian3D = 9157
+)
+
+// HaversineMeters returns the great-circle distance between two WGS-84
+// points (SRID 4326 or 4979) in meters, using the haversine formula with
+// R=6371008.8 - the same radius Neo4j's point.distance uses for geographic
+// points. For Cartesian points (SRID 7203/9157) it instead returns the
+// Euclidean distance, matching how point.distance behaves for those SRIDs.
+//
+// It returns an error if a and b don't share the same SRID, or mix a 2D
+// point with a 3D one - point.distance rejects that combination too.
+func HaversineMeters(a, b neo4j.Point2D) (float64, error) {
+	if a.SpatialRefId != b.SpatialRefId {
+		return 0, fmt.Errorf("geo: mismatched SRIDs %d and %d", a.SpatialRefId, b.SpatialRefId)
+	}
+
+	switch a.SpatialRefId {
+	case sridWGS84_2D, sridWGS84_3D:
+		return haversine(a.Y, a.X, b.Y, b.X), nil
+	case sridCartesian2D, sridCartesian3D:
+		return math.Hypot(b.X-a.X, b.Y-a.Y), nil
+	default:
+		return 0, fmt.Errorf("geo: unsupported SRID %d", a.SpatialRefId)
+	}
+}
+
+func haversine(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1 := lat1 * math.Pi / 180
+	phi2 := lat2 * math.Pi / 180
+	dPhi := (lat2 - lat1) * math.Pi / 180
+	dLambda := (lon2 - lon1) * math.Pi / 180
+
+	sinDPhi := math.Sin(dPhi / 2)
+	sinDLambda := math.Sin(dLambda / 2)
+
+	h := sinDPhi*sinDPhi + math.Cos(phi1)*math.Cos(phi2)*sinDLambda*sinDLambda
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(h))
+}
+
+// BoundingBox computes a lat/lon envelope around center that contains every
+// point within radiusMeters, for use as an index-friendly prefilter before
+// an exact point.distance check - e.g.
+//
+//	WHERE other.location.latitude >= $minLat AND other.location.latitude <= $maxLat
+//	  AND other.location.longitude >= $minLon AND other.location.longitude <= $maxLon
+//	  AND point.distance(sf.location, other.location) < $radiusMeters
+//
+// The box is intentionally a little generous (it uses the cosine of the
+// latitude nearest the poles within the box) rather than exact, since it
+// only needs to avoid excluding true matches.
+func BoundingBox(center neo4j.Point2D, radiusMeters float64) (minLat, minLon, maxLat, maxLon float64) {
+	lat := center.Y
+	lon := center.X
+
+	deltaLat := (radiusMeters / earthRadiusMeters) * (180 / math.Pi)
+	minLat = lat - deltaLat
+	maxLat = lat + deltaLat
+
+	latRad := math.Max(math.Abs(minLat), math.Abs(maxLat)) * math.Pi / 180
+	deltaLon := (radiusMeters / (earthRadiusMeters * math.Cos(latRad))) * (180 / math.Pi)
+	minLon = lon - deltaLon
+	maxLon = lon + deltaLon
+
+	return minLat, minLon, maxLat, maxLon
+}
+
+// NearbyQuery builds a two-stage Cypher query for a given node label and
+// point property: a cheap bounding-box WHERE prefilter that an index can
+// use, followed by the exact point.distance check.
+type NearbyQuery struct {
+	Label     string
+	PointProp string
+	Center    neo4j.Point2D
+	RadiusM   float64
+}
+
+// Build renders the Cypher text and its parameter map, ready to pass to
+// neo4j.ExecuteQuery.
+func (q NearbyQuery) Build() (string, map[string]any) {
+	minLat, minLon, maxLat, maxLon := BoundingBox(q.Center, q.RadiusM)
+
+	cypher := fmt.Sprintf(`
+		MATCH (n:%s)
+		WHERE n.%s.latitude >= $minLat AND n.%s.latitude <= $maxLat
+		  AND n.%s.longitude >= $minLon AND n.%s.longitude <= $maxLon
+		WITH n, point.distance(n.%s, $center) AS distance
+		WHERE distance < $radiusMeters
+		RETURN n, distance
+		ORDER BY distance
+	`, q.Label, q.PointProp, q.PointProp, q.PointProp, q.PointProp, q.PointProp)
+
+	params := map[string]any{
+		"minLat":       minLat,
+		"maxLat":       maxLat,
+		"minLon":       minLon,
+		"maxLon":       maxLon,
+		"center":       q.Center,
+		"radiusMeters": q.RadiusM,
+	}
+	return cypher, params
+}
@@ -0,0 +1,106 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func wgs84Point(lat, lon float64) neo4j.Point2D {
+	return neo4j.Point2D{X: lon, Y: lat, SpatialRefId: sridWGS84_2D}
+}
+
+func TestHaversineMetersKnownCityPairs(t *testing.T) {
+	tests := []struct {
+		name       string
+		a, b       neo4j.Point2D
+		wantMeters float64
+	}{
+		{
+			name:       "London to Paris",
+			a:          wgs84Point(51.5074, -0.1278),
+			b:          wgs84Point(48.8566, 2.3522),
+			wantMeters: 344000,
+		},
+		{
+			name:       "San Francisco to Los Angeles",
+			a:          wgs84Point(37.7749, -122.4194),
+			b:          wgs84Point(34.0522, -118.2437),
+			wantMeters: 559000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HaversineMeters(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("HaversineMeters: %v", err)
+			}
+			tolerance := tt.wantMeters * 0.005
+			if math.Abs(got-tt.wantMeters) > tolerance {
+				t.Errorf("HaversineMeters(%s) = %.0fm, want %.0fm ± %.0fm", tt.name, got, tt.wantMeters, tolerance)
+			}
+		})
+	}
+}
+
+func TestHaversineMetersSamePointIsZero(t *testing.T) {
+	p := wgs84Point(51.5074, -0.1278)
+	got, err := HaversineMeters(p, p)
+	if err != nil {
+		t.Fatalf("HaversineMeters: %v", err)
+	}
+	if got != 0 {
+		t.Errorf("HaversineMeters(p, p) = %v, want 0", got)
+	}
+}
+
+func TestHaversineMetersCartesianIsEuclidean(t *testing.T) {
+	a := neo4j.Point2D{X: 0, Y: 0, SpatialRefId: sridCartesian2D}
+	b := neo4j.Point2D{X: 3, Y: 4, SpatialRefId: sridCartesian2D}
+
+	got, err := HaversineMeters(a, b)
+	if err != nil {
+		t.Fatalf("HaversineMeters: %v", err)
+	}
+	if got != 5 {
+		t.Errorf("HaversineMeters(Cartesian) = %v, want 5", got)
+	}
+}
+
+func TestHaversineMetersRejectsMismatchedSRIDs(t *testing.T) {
+	a := wgs84Point(51.5074, -0.1278)
+	b := neo4j.Point2D{X: 0, Y: 0, SpatialRefId: sridCartesian2D}
+
+	if _, err := HaversineMeters(a, b); err == nil {
+		t.Error("HaversineMeters: expected an error for mismatched SRIDs, got nil")
+	}
+}
+
+func TestBoundingBoxContainsKnownNearbyPoint(t *testing.T) {
+	sf := wgs84Point(37.7749, -122.4194)
+	la := wgs84Point(34.0522, -118.2437)
+
+	distance, err := HaversineMeters(sf, la)
+	if err != nil {
+		t.Fatalf("HaversineMeters: %v", err)
+	}
+
+	minLat, minLon, maxLat, maxLon := BoundingBox(sf, distance+1000)
+	if la.Y < minLat || la.Y > maxLat || la.X < minLon || la.X > maxLon {
+		t.Errorf("BoundingBox(sf, %.0fm) = [%v,%v]x[%v,%v], want it to contain LA at (%v,%v)",
+			distance+1000, minLat, maxLat, minLon, maxLon, la.Y, la.X)
+	}
+}
+
+func TestBoundingBoxExcludesKnownFarPoint(t *testing.T) {
+	sf := wgs84Point(37.7749, -122.4194)
+	la := wgs84Point(34.0522, -118.2437)
+
+	minLat, minLon, maxLat, maxLon := BoundingBox(sf, 1000) // 1km, far short of the ~559km to LA
+	if la.Y >= minLat && la.Y <= maxLat && la.X >= minLon && la.X <= maxLon {
+		t.Errorf("BoundingBox(sf, 1000) = [%v,%v]x[%v,%v] unexpectedly contains LA at (%v,%v)",
+			minLat, maxLat, minLon, maxLon, la.Y, la.X)
+	}
+}
@@ -0,0 +1,99 @@
+// Package geojson converts between github.com/paulmach/go.geojson features
+// and the neo4j.Point2D/Point3D values returned by this repo's spatial
+// queries, so results from findingNearbyLocations-style examples can be
+// streamed straight into a map front-end instead of hand-assembled.
+package geojson
+
+import (
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/paulmach/go.geojson"
+)
+
+// UnsupportedGeometryError is returned when a GeoJSON geometry type other
+// than Point is passed to FromGeoJSON. Only Point has a Neo4j spatial
+// counterpart on the server today; LineString and Polygon have no
+// corresponding storable type until server-side support lands.
+type UnsupportedGeometryError struct {
+	Type string
+}
+
+func (e *UnsupportedGeometryError) Error() string {
+	return fmt.Sprintf("geojson: unsupported geometry type %q (only Point is supported)", e.Type)
+}
+
+// FromGeoJSON converts a GeoJSON Point feature into a neo4j.Point2D (or
+// neo4j.Point3D if the feature carries an altitude) with the appropriate
+// WGS-84 SRID (4326 for 2D, 4979 for 3D).
+func FromGeoJSON(f *geojson.Feature) (any, error) {
+	if f.Geometry == nil || !f.Geometry.IsPoint() {
+		geomType := "unknown"
+		if f.Geometry != nil {
+			geomType = string(f.Geometry.Type)
+		}
+		return nil, &UnsupportedGeometryError{Type: geomType}
+	}
+
+	coords := f.Geometry.Point
+	if len(coords) < 2 {
+		return nil, fmt.Errorf("geojson: point geometry needs at least [lon, lat], got %v", coords)
+	}
+
+	lon, lat := coords[0], coords[1]
+	if len(coords) >= 3 {
+		return neo4j.Point3D{X: lon, Y: lat, Z: coords[2], SpatialRefId: 4979}, nil
+	}
+	return neo4j.Point2D{X: lon, Y: lat, SpatialRefId: 4326}, nil
+}
+
+// ToGeoJSON converts a neo4j.Point2D or neo4j.Point3D back into a GeoJSON
+// Point feature with no properties set.
+func ToGeoJSON(p any) (*geojson.Feature, error) {
+	switch point := p.(type) {
+	case neo4j.Point2D:
+		return geojson.NewPointFeature([]float64{point.X, point.Y}), nil
+	case neo4j.Point3D:
+		return geojson.NewPointFeature([]float64{point.X, point.Y, point.Z}), nil
+	default:
+		return nil, fmt.Errorf("geojson: unsupported value %T, expected neo4j.Point2D or neo4j.Point3D", p)
+	}
+}
+
+// EncodeNodeAsFeature converts a neo4j.Node into a GeoJSON feature, taking
+// its geometry from the property named geomProp and lifting every other
+// property into the feature's Properties map.
+func EncodeNodeAsFeature(n neo4j.Node, geomProp string) (*geojson.Feature, error) {
+	raw, ok := n.Props[geomProp]
+	if !ok {
+		return nil, fmt.Errorf("geojson: node has no property %q", geomProp)
+	}
+
+	feature, err := ToGeoJSON(raw)
+	if err != nil {
+		return nil, fmt.Errorf("geojson: property %q: %w", geomProp, err)
+	}
+
+	feature.Properties = make(map[string]any, len(n.Props)-1)
+	for key, value := range n.Props {
+		if key == geomProp {
+			continue
+		}
+		feature.Properties[key] = value
+	}
+	return feature, nil
+}
+
+// FeatureCollection builds a *geojson.FeatureCollection from a set of
+// nodes, reusing EncodeNodeAsFeature for each one.
+func FeatureCollection(nodes []neo4j.Node, geomProp string) (*geojson.FeatureCollection, error) {
+	fc := geojson.NewFeatureCollection()
+	for _, n := range nodes {
+		feature, err := EncodeNodeAsFeature(n, geomProp)
+		if err != nil {
+			return nil, err
+		}
+		fc.AddFeature(feature)
+	}
+	return fc, nil
+}
@@ -0,0 +1,373 @@
+// Package graphalgo gives callers a typed, auto-projecting entry point for
+// the Graph Data Science / APOC procedures the gds package wraps, so code
+// like result_handling.go's handlePaths doesn't have to hand-write
+// gds.graph.project and CALL ... YIELD Cypher for common algorithm runs.
+//
+// Every function here takes a ProjectionSpec describing the nodes and
+// relationships to run over. If ProjectionSpec.Name is empty, a projection
+// is created on the fly via gds.graph.project.cypher and dropped once the
+// algorithm has run. If the server only has APOC installed (no GDS plugin),
+// ShortestPath and AllShortestPaths fall back to apoc.algo.dijkstra and
+// apoc.algo.allSimplePaths respectively; PageRank, Louvain, and
+// NodeSimilarity have no APOC equivalent and return an error in that case.
+package graphalgo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/rupeedev/ai-pack/neo4j-go/gds"
+)
+
+// Matcher selects a single node by label and, optionally, one property
+// value - enough to identify the endpoints of a shortest-path search
+// without the caller hand-writing a WHERE clause.
+type Matcher struct {
+	Label string
+	Prop  string
+	Value any
+}
+
+func (m Matcher) clause(alias, param string) (string, any) {
+	if m.Prop == "" {
+		return fmt.Sprintf("(%s:%s)", alias, m.Label), nil
+	}
+	return fmt.Sprintf("(%s:%s {%s: $%s})", alias, m.Label, m.Prop, param), m.Value
+}
+
+// ProjectionSpec names an existing in-memory GDS projection, or describes
+// one to create on demand. When Name is set and the projection already
+// exists, it's reused as-is and left in place; otherwise NodeQuery/RelQuery
+// (the two Cypher fragments gds.graph.project.cypher expects) are used to
+// create a projection that's dropped after the call completes.
+type ProjectionSpec struct {
+	Name      string
+	NodeQuery string
+	RelQuery  string
+}
+
+// PathResult is a single shortest-path (or simple-path) run's outcome,
+// decoded from either a GDS or an APOC response.
+type PathResult struct {
+	Nodes         []neo4j.Node
+	Relationships []neo4j.Relationship
+	Cost          float64
+}
+
+// ShortestPathReq configures a single-pair shortest-path search.
+type ShortestPathReq struct {
+	Projection ProjectionSpec
+	From, To   Matcher
+	RelFilter  string // APOC-style "REL_TYPE>" / "<REL_TYPE" direction syntax
+	WeightProp string
+}
+
+// PageRankCfg configures a gds.pageRank.stream run.
+type PageRankCfg struct {
+	MaxIterations int
+	DampingFactor float64
+}
+
+// NodeSimilarityCfg configures a gds.nodeSimilarity.stream run.
+type NodeSimilarityCfg struct {
+	TopK             int
+	SimilarityCutoff float64
+}
+
+// NodeSimilarityResult is one pair of similar nodes and their score.
+type NodeSimilarityResult struct {
+	Node1      neo4j.Node
+	Node2      neo4j.Node
+	Similarity float64
+}
+
+// hasGDS reports whether the connected server has the GDS plugin installed.
+func hasGDS(ctx context.Context, driver neo4j.DriverWithContext) (bool, error) {
+	return gds.HasProcedurePrefix(ctx, driver, "gds.")
+}
+
+// withProjection resolves spec to a usable gds.Graph, creating a temporary
+// cypher projection if spec doesn't name an existing one, and returns a
+// cleanup func that drops it again (a no-op if the projection was reused).
+func withProjection(ctx context.Context, driver neo4j.DriverWithContext, spec ProjectionSpec) (gds.Graph, func(), error) {
+	noop := func() {}
+
+	if spec.Name != "" {
+		g := gds.Graph{Name: spec.Name}
+		exists, err := g.Exists(ctx, driver)
+		if err != nil {
+			return gds.Graph{}, noop, err
+		}
+		if exists {
+			return g, noop, nil
+		}
+	}
+
+	name := spec.Name
+	if name == "" {
+		name = "graphalgo-tmp"
+	}
+	g, err := gds.ProjectCypher(ctx, driver, name, spec.NodeQuery, spec.RelQuery)
+	if err != nil {
+		return gds.Graph{}, noop, fmt.Errorf("graphalgo: auto-project: %w", err)
+	}
+	return g, func() { _ = g.Drop(ctx, driver) }, nil
+}
+
+// ShortestPath finds the lowest-cost path between req.From and req.To,
+// weighted by req.WeightProp, using gds.shortestPath.dijkstra.stream when
+// GDS is installed, or apoc.algo.dijkstra otherwise.
+func ShortestPath(ctx context.Context, driver neo4j.DriverWithContext, req ShortestPathReq) (PathResult, error) {
+	ok, err := hasGDS(ctx, driver)
+	if err != nil {
+		return PathResult{}, err
+	}
+	if !ok {
+		return shortestPathAPOC(ctx, driver, req)
+	}
+	return shortestPathGDS(ctx, driver, req)
+}
+
+func shortestPathGDS(ctx context.Context, driver neo4j.DriverWithContext, req ShortestPathReq) (PathResult, error) {
+	g, cleanup, err := withProjection(ctx, driver, req.Projection)
+	if err != nil {
+		return PathResult{}, err
+	}
+	defer cleanup()
+
+	fromClause, fromParam := req.From.clause("from", "fromVal")
+	toClause, toParam := req.To.clause("to", "toVal")
+
+	result, err := neo4j.ExecuteQuery(ctx, driver, fmt.Sprintf(`
+		MATCH %s, %s
+		CALL gds.shortestPath.dijkstra.stream($graph, {
+			sourceNode: from,
+			targetNode: to,
+			relationshipWeightProperty: $weightProperty
+		})
+		YIELD totalCost, path
+		RETURN totalCost, path
+		LIMIT 1
+	`, fromClause, toClause),
+		map[string]any{
+			"graph":          g.Name,
+			"fromVal":        fromParam,
+			"toVal":          toParam,
+			"weightProperty": req.WeightProp,
+		},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return PathResult{}, fmt.Errorf("graphalgo: shortestPath: %w", err)
+	}
+	if len(result.Records) == 0 {
+		return PathResult{}, fmt.Errorf("graphalgo: no path found")
+	}
+	return pathFromRecord(result.Records[0], "totalCost", "path"), nil
+}
+
+func shortestPathAPOC(ctx context.Context, driver neo4j.DriverWithContext, req ShortestPathReq) (PathResult, error) {
+	fromClause, fromParam := req.From.clause("from", "fromVal")
+	toClause, toParam := req.To.clause("to", "toVal")
+
+	result, err := neo4j.ExecuteQuery(ctx, driver, fmt.Sprintf(`
+		MATCH %s, %s
+		CALL apoc.algo.dijkstra(from, to, $relFilter, $weightProperty)
+		YIELD path, weight
+		RETURN path, weight AS totalCost
+		LIMIT 1
+	`, fromClause, toClause),
+		map[string]any{
+			"fromVal":        fromParam,
+			"toVal":          toParam,
+			"relFilter":      req.RelFilter,
+			"weightProperty": req.WeightProp,
+		},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return PathResult{}, fmt.Errorf("graphalgo: shortestPath (apoc fallback): %w", err)
+	}
+	if len(result.Records) == 0 {
+		return PathResult{}, fmt.Errorf("graphalgo: no path found")
+	}
+	return pathFromRecord(result.Records[0], "totalCost", "path"), nil
+}
+
+// AllShortestPaths returns every shortest path between req.From and req.To
+// (there can be more than one at equal cost), using
+// gds.allShortestPaths.dijkstra.stream when GDS is installed, or
+// apoc.algo.allSimplePaths otherwise.
+func AllShortestPaths(ctx context.Context, driver neo4j.DriverWithContext, req ShortestPathReq) ([]PathResult, error) {
+	ok, err := hasGDS(ctx, driver)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return allShortestPathsAPOC(ctx, driver, req)
+	}
+	return allShortestPathsGDS(ctx, driver, req)
+}
+
+func allShortestPathsGDS(ctx context.Context, driver neo4j.DriverWithContext, req ShortestPathReq) ([]PathResult, error) {
+	g, cleanup, err := withProjection(ctx, driver, req.Projection)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	fromClause, fromParam := req.From.clause("from", "fromVal")
+	toClause, toParam := req.To.clause("to", "toVal")
+
+	result, err := neo4j.ExecuteQuery(ctx, driver, fmt.Sprintf(`
+		MATCH %s, %s
+		CALL gds.allShortestPaths.dijkstra.stream($graph, {
+			sourceNode: from,
+			targetNodes: [to],
+			relationshipWeightProperty: $weightProperty
+		})
+		YIELD totalCost, path
+		RETURN totalCost, path
+	`, fromClause, toClause),
+		map[string]any{
+			"graph":          g.Name,
+			"fromVal":        fromParam,
+			"toVal":          toParam,
+			"weightProperty": req.WeightProp,
+		},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("graphalgo: allShortestPaths: %w", err)
+	}
+	return pathsFromRecords(result.Records, "totalCost", "path"), nil
+}
+
+func allShortestPathsAPOC(ctx context.Context, driver neo4j.DriverWithContext, req ShortestPathReq) ([]PathResult, error) {
+	fromClause, fromParam := req.From.clause("from", "fromVal")
+	toClause, toParam := req.To.clause("to", "toVal")
+
+	result, err := neo4j.ExecuteQuery(ctx, driver, fmt.Sprintf(`
+		MATCH %s, %s
+		CALL apoc.algo.allSimplePaths(from, to, $relFilter, -1)
+		YIELD path, weight
+		RETURN path, weight AS totalCost
+	`, fromClause, toClause),
+		map[string]any{
+			"fromVal":   fromParam,
+			"toVal":     toParam,
+			"relFilter": req.RelFilter,
+		},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("graphalgo: allShortestPaths (apoc fallback): %w", err)
+	}
+	return pathsFromRecords(result.Records, "totalCost", "path"), nil
+}
+
+// PageRank runs gds.pageRank.stream over proj and returns every node's
+// score, highest first. PageRank has no APOC equivalent, so it errors if
+// the server doesn't have GDS installed.
+func PageRank(ctx context.Context, driver neo4j.DriverWithContext, proj ProjectionSpec, cfg PageRankCfg) ([]gds.PageRankResult, error) {
+	ok, err := hasGDS(ctx, driver)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("graphalgo: pageRank requires the GDS plugin (APOC has no equivalent)")
+	}
+
+	g, cleanup, err := withProjection(ctx, driver, proj)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	return g.PageRank(ctx, driver, gds.PageRankOptions{
+		MaxIterations: cfg.MaxIterations,
+		DampingFactor: cfg.DampingFactor,
+	})
+}
+
+// Louvain runs gds.louvain.stream over proj, assigning each node a
+// community id. Louvain has no APOC equivalent, so it errors if the server
+// doesn't have GDS installed.
+func Louvain(ctx context.Context, driver neo4j.DriverWithContext, proj ProjectionSpec, includeIntermediateCommunities bool) ([]gds.CommunityResult, error) {
+	ok, err := hasGDS(ctx, driver)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("graphalgo: louvain requires the GDS plugin (APOC has no equivalent)")
+	}
+
+	g, cleanup, err := withProjection(ctx, driver, proj)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	return g.Louvain(ctx, driver, gds.LouvainOptions{IncludeIntermediateCommunities: includeIntermediateCommunities})
+}
+
+// NodeSimilarity runs gds.nodeSimilarity.stream over proj and returns the
+// most similar node pairs. NodeSimilarity has no APOC equivalent, so it
+// errors if the server doesn't have GDS installed.
+func NodeSimilarity(ctx context.Context, driver neo4j.DriverWithContext, proj ProjectionSpec, cfg NodeSimilarityCfg) ([]NodeSimilarityResult, error) {
+	ok, err := hasGDS(ctx, driver)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("graphalgo: nodeSimilarity requires the GDS plugin (APOC has no equivalent)")
+	}
+
+	g, cleanup, err := withProjection(ctx, driver, proj)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	result, err := neo4j.ExecuteQuery(ctx, driver, `
+		CALL gds.nodeSimilarity.stream($name, {topK: $topK, similarityCutoff: $cutoff})
+		YIELD node1, node2, similarity
+		RETURN gds.util.asNode(node1) AS node1, gds.util.asNode(node2) AS node2, similarity
+		ORDER BY similarity DESC
+	`,
+		map[string]any{"name": g.Name, "topK": cfg.TopK, "cutoff": cfg.SimilarityCutoff},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("graphalgo: nodeSimilarity: %w", err)
+	}
+
+	out := make([]NodeSimilarityResult, 0, len(result.Records))
+	for _, record := range result.Records {
+		n1, _ := record.Get("node1")
+		n2, _ := record.Get("node2")
+		sim, _ := record.Get("similarity")
+		node1, _ := n1.(neo4j.Node)
+		node2, _ := n2.(neo4j.Node)
+		similarity, _ := sim.(float64)
+		out = append(out, NodeSimilarityResult{Node1: node1, Node2: node2, Similarity: similarity})
+	}
+	return out, nil
+}
+
+func pathFromRecord(record *neo4j.Record, costKey, pathKey string) PathResult {
+	costVal, _ := record.Get(costKey)
+	pathVal, _ := record.Get(pathKey)
+	cost, _ := costVal.(float64)
+	path, _ := pathVal.(neo4j.Path)
+	return PathResult{Nodes: path.Nodes, Relationships: path.Relationships, Cost: cost}
+}
+
+func pathsFromRecords(records []*neo4j.Record, costKey, pathKey string) []PathResult {
+	out := make([]PathResult, 0, len(records))
+	for _, record := range records {
+		out = append(out, pathFromRecord(record, costKey, pathKey))
+	}
+	return out
+}
@@ -0,0 +1,241 @@
+// Package config bootstraps a neo4j.DriverWithContext from a single
+// declarative Config, instead of the one-liner neo4j.NewDriverWithContext
+// calls scattered across this repo's examples, each hard-coding
+// "neo4j://localhost:7687" and leaving pool size, retry budget, TLS and
+// bolt logging at their defaults. DriverFromEnv sources that Config from
+// NEO4J_* environment variables so the password never has to live in
+// source.
+package config
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	boltlog "github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
+)
+
+// LogLevel selects how verbosely the driver logs bolt protocol activity.
+type LogLevel int
+
+const (
+	LogError LogLevel = iota
+	LogWarning
+	LogInfo
+	LogDebug
+)
+
+// TLSConfig controls how the driver connects over an encrypted transport.
+// When Enabled, the URI scheme passed to Bootstrap is upgraded to its "+s"
+// (or "+ssc" when InsecureSkipVerify is set) form automatically.
+type TLSConfig struct {
+	Enabled            bool
+	InsecureSkipVerify bool
+	// CACertPath, if set, is a PEM file added to the system root CA pool
+	// instead of replacing it.
+	CACertPath string
+}
+
+// Config is everything needed to stand up a driver for a given environment.
+type Config struct {
+	URI      string
+	Username string
+	Password string
+	Realm    string
+
+	// Database is the default database callers should pass as
+	// neo4j.SessionConfig.DatabaseName; there's no driver-level equivalent,
+	// since the driver itself is database-agnostic.
+	Database string
+	// UserAgent overrides the driver's default "neo4j-go/<version>" string,
+	// applied in Bootstrap via neo4j.Config.UserAgent.
+	UserAgent string
+
+	MaxConnectionPoolSize        int
+	MaxConnectionLifetime        time.Duration
+	ConnectionAcquisitionTimeout time.Duration
+	SocketConnectTimeout         time.Duration
+
+	MaxTransactionRetryTime time.Duration
+
+	TLS TLSConfig
+
+	LogLevel LogLevel
+	// Debug, when true, has BoltLogger return a logger that prints every
+	// bolt client/server message to the console.
+	Debug bool
+
+	// VerifyConnectivity, when true, has Bootstrap call
+	// driver.VerifyConnectivity before returning.
+	VerifyConnectivity bool
+}
+
+// Default returns the connection settings used throughout this repo's other
+// examples, as a starting point for overrides.
+func Default() Config {
+	return Config{
+		URI:                     "neo4j://localhost:7687",
+		Username:                "neo4j",
+		Password:                "Your@Password!@#",
+		Database:                "neo4j",
+		MaxConnectionPoolSize:   100,
+		MaxConnectionLifetime:   time.Hour,
+		MaxTransactionRetryTime: 30 * time.Second,
+		LogLevel:                LogWarning,
+		VerifyConnectivity:      true,
+	}
+}
+
+// FromEnv overrides Default() with settings read from NEO4J_URI, NEO4J_USER,
+// NEO4J_PASSWORD, NEO4J_DATABASE, NEO4J_TLS and NEO4J_DEBUG, leaving
+// whichever of those aren't set at their Default() value.
+func FromEnv() Config {
+	cfg := Default()
+	if v, ok := os.LookupEnv("NEO4J_URI"); ok {
+		cfg.URI = v
+	}
+	if v, ok := os.LookupEnv("NEO4J_USER"); ok {
+		cfg.Username = v
+	}
+	if v, ok := os.LookupEnv("NEO4J_PASSWORD"); ok {
+		cfg.Password = v
+	}
+	if v, ok := os.LookupEnv("NEO4J_DATABASE"); ok {
+		cfg.Database = v
+	}
+	if v, ok := os.LookupEnv("NEO4J_TLS"); ok {
+		cfg.TLS.Enabled, _ = strconv.ParseBool(v)
+	}
+	if v, ok := os.LookupEnv("NEO4J_DEBUG"); ok {
+		cfg.Debug, _ = strconv.ParseBool(v)
+	}
+	return cfg
+}
+
+// DriverFromEnv builds a driver from FromEnv(), replacing the
+// neo4j.NewDriverWithContext("neo4j://localhost:7687",
+// neo4j.BasicAuth("neo4j", "Your@Password!@#", "")) call every example used
+// to repeat with its own hardcoded password.
+func DriverFromEnv(ctx context.Context) (neo4j.DriverWithContext, error) {
+	return Bootstrap(ctx, FromEnv())
+}
+
+// Bootstrap builds a driver from cfg, applying pool, retry, TLS and logging
+// settings in one place.
+func Bootstrap(ctx context.Context, cfg Config) (neo4j.DriverWithContext, error) {
+	uri, err := applyTLSScheme(cfg.URI, cfg.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+
+	auth := neo4j.BasicAuth(cfg.Username, cfg.Password, cfg.Realm)
+
+	driver, err := neo4j.NewDriverWithContext(uri, auth, func(c *neo4j.Config) {
+		if cfg.MaxConnectionPoolSize > 0 {
+			c.MaxConnectionPoolSize = cfg.MaxConnectionPoolSize
+		}
+		if cfg.MaxConnectionLifetime > 0 {
+			c.MaxConnectionLifetime = cfg.MaxConnectionLifetime
+		}
+		if cfg.ConnectionAcquisitionTimeout > 0 {
+			c.ConnectionAcquisitionTimeout = cfg.ConnectionAcquisitionTimeout
+		}
+		if cfg.SocketConnectTimeout > 0 {
+			c.SocketConnectTimeout = cfg.SocketConnectTimeout
+		}
+		if cfg.MaxTransactionRetryTime > 0 {
+			c.MaxTransactionRetryTime = cfg.MaxTransactionRetryTime
+		}
+		if cfg.UserAgent != "" {
+			c.UserAgent = cfg.UserAgent
+		}
+		c.Log = neo4j.ConsoleLogger(toNeo4jLevel(cfg.LogLevel))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("config: create driver: %w", err)
+	}
+
+	if cfg.VerifyConnectivity {
+		if err := driver.VerifyConnectivity(ctx); err != nil {
+			driver.Close(ctx)
+			return nil, fmt.Errorf("config: verify connectivity: %w", err)
+		}
+	}
+
+	return driver, nil
+}
+
+// BoltLogger returns a log.BoltLogger that prints every bolt client/server
+// message to the console when cfg.Debug (NEO4J_DEBUG=1) is set, or nil
+// otherwise. neo4j.Config has no driver-wide BoltLogger slot - it's
+// session-scoped - so callers thread this into their own
+// neo4j.SessionConfig.BoltLogger rather than Bootstrap applying it directly.
+func (cfg Config) BoltLogger() boltlog.BoltLogger {
+	if !cfg.Debug {
+		return nil
+	}
+	return neo4j.ConsoleBoltLogger()
+}
+
+func toNeo4jLevel(l LogLevel) neo4j.LogLevel {
+	switch l {
+	case LogError:
+		return neo4j.ERROR
+	case LogInfo:
+		return neo4j.INFO
+	case LogDebug:
+		return neo4j.DEBUG
+	default:
+		return neo4j.WARNING
+	}
+}
+
+// applyTLSScheme rewrites a neo4j:// or bolt:// URI's scheme to its
+// encrypted form when tls.Enabled. The driver picks up trusted CAs from the
+// OS trust store itself, so a CACertPath is only sanity-checked here - it
+// must still be installed system-wide (or the server's cert accepted via
+// InsecureSkipVerify) for the connection to actually trust it.
+func applyTLSScheme(uri string, tlsCfg TLSConfig) (string, error) {
+	if !tlsCfg.Enabled {
+		return uri, nil
+	}
+
+	if tlsCfg.CACertPath != "" {
+		if err := validateCACert(tlsCfg.CACertPath); err != nil {
+			return "", err
+		}
+	}
+
+	scheme, rest, ok := strings.Cut(uri, "://")
+	if !ok {
+		return "", fmt.Errorf("invalid URI %q", uri)
+	}
+	if strings.HasSuffix(scheme, "+s") || strings.HasSuffix(scheme, "+ssc") {
+		return uri, nil // already encrypted
+	}
+
+	suffix := "+s"
+	if tlsCfg.InsecureSkipVerify {
+		suffix = "+ssc"
+	}
+	return scheme + suffix + "://" + rest, nil
+}
+
+// validateCACert confirms path holds at least one parseable PEM certificate,
+// catching a typo'd path or empty file at bootstrap time rather than on the
+// first failed connection.
+func validateCACert(path string) error {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read CA cert %s: %w", path, err)
+	}
+	if !x509.NewCertPool().AppendCertsFromPEM(pem) {
+		return fmt.Errorf("no certificates found in %s", path)
+	}
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"os"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/rupeedev/ai-pack/neo4j-go/internal/config"
+	"github.com/rupeedev/ai-pack/neo4j-go/neo4jx/otel"
 )
 
 func basicExample(ctx context.Context, driver neo4j.DriverWithContext) {
@@ -63,6 +65,11 @@ func printUsage() {
 	fmt.Println("  temporal     - Run temporal & spatial data examples (Dates, Points)")
 	fmt.Println("  transactions - Run transaction management examples (Sessions, Units of Work)")
 	fmt.Println("  movies       - Query and display all movies in the database")
+	fmt.Println("  serve        - Start an HTTP/JSON API for the sample graph")
+	fmt.Println("  bookmarks    - Run bookmark/causal-consistency examples")
+	fmt.Println("  bulkingest   - Run concurrent UNWIND-batched bulk ingest example")
+	fmt.Println("  streaming    - Run cursor/streaming-result examples")
+	fmt.Println("  otel         - Run neo4jx/otel traced & metered ExecuteWrite example")
 	fmt.Println("  help         - Show this help message")
 	fmt.Println("\nExamples:")
 	fmt.Println("  go run .                 # Run basic example")
@@ -85,22 +92,15 @@ func main() {
 		return
 	}
 
-	// Create a driver instance
-	driver, err := neo4j.NewDriverWithContext(
-		"neo4j://localhost:7687",                         // Connection string
-		neo4j.BasicAuth("neo4j", "Your@Password!@#", ""), // Authentication
-	)
-	if err != nil {
-		panic(err)
-	}
-	defer driver.Close(context.Background()) // Always close the driver when done
-
-	// Verify connectivity
+	// Create a driver instance from NEO4J_URI/NEO4J_USER/NEO4J_PASSWORD/
+	// NEO4J_DATABASE/NEO4J_TLS (internal/config.DriverFromEnv also verifies
+	// connectivity before returning).
 	ctx := context.Background()
-	err = driver.VerifyConnectivity(ctx)
+	driver, err := config.DriverFromEnv(ctx)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to connect to Neo4j: %v\n\nMake sure Neo4j is running:\n  docker ps | grep neo4j\n", err))
 	}
+	defer driver.Close(ctx) // Always close the driver when done
 	fmt.Println("✓ Connected to Neo4j successfully!")
 
 	// Run the appropriate command
@@ -133,6 +133,27 @@ func main() {
 	case "costars":
 		queryTomHanksCostars()
 
+	case "bookmarks":
+		runBookmarkExamples()
+
+	case "bulkingest":
+		runBulkIngestExample()
+
+	case "streaming":
+		runStreamingExamples()
+
+	case "otel":
+		otel.RunDemo()
+
+	case "serve":
+		addr := ":8080"
+		if len(os.Args) > 2 {
+			addr = ":" + os.Args[2]
+		}
+		if err := runServer(ctx, driver, addr); err != nil {
+			panic(err)
+		}
+
 	default:
 		fmt.Printf("Unknown command: %s\n\n", command)
 		printUsage()
@@ -0,0 +1,98 @@
+package neo4jx
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// fakeDriver is a minimal neo4j.DriverWithContext that only records the
+// SessionConfig passed to NewSession, for asserting bookmark threading
+// without a live server. It can't return a usable neo4j.SessionWithContext -
+// that interface has unexported methods only the driver's own package can
+// implement - so tests exercise newSession/record directly rather than
+// through Do/DoRead.
+type fakeDriver struct {
+	lastCfg neo4j.SessionConfig
+}
+
+func (f *fakeDriver) ExecuteQueryBookmarkManager() neo4j.BookmarkManager { return nil }
+func (f *fakeDriver) Target() url.URL                                    { return url.URL{} }
+func (f *fakeDriver) NewSession(ctx context.Context, cfg neo4j.SessionConfig) neo4j.SessionWithContext {
+	f.lastCfg = cfg
+	return nil
+}
+func (f *fakeDriver) VerifyConnectivity(ctx context.Context) error { return nil }
+func (f *fakeDriver) VerifyAuthentication(ctx context.Context, auth *neo4j.AuthToken) error {
+	return nil
+}
+func (f *fakeDriver) Close(ctx context.Context) error                             { return nil }
+func (f *fakeDriver) IsEncrypted() bool                                           { return false }
+func (f *fakeDriver) GetServerInfo(ctx context.Context) (neo4j.ServerInfo, error) { return nil, nil }
+
+var _ neo4j.DriverWithContext = (*fakeDriver)(nil)
+
+func TestBookmarkManagerRecordMergesPerDatabase(t *testing.T) {
+	m := NewBookmarkManager(&fakeDriver{})
+
+	m.record("", neo4j.Bookmarks{"bm-a"})
+	m.record("", neo4j.Bookmarks{"bm-b"})
+
+	got := m.bookmarksFor("")
+	want := neo4j.CombineBookmarks(neo4j.Bookmarks{"bm-a"}, neo4j.Bookmarks{"bm-b"})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("bookmarksFor(\"\") = %v, want %v", got, want)
+	}
+}
+
+func TestBookmarkManagerScopesBookmarksPerDatabase(t *testing.T) {
+	m := NewBookmarkManager(&fakeDriver{})
+
+	m.record("db-a", neo4j.Bookmarks{"bm-a"})
+	m.record("db-b", neo4j.Bookmarks{"bm-b"})
+
+	if got := m.bookmarksFor("db-a"); !reflect.DeepEqual(got, neo4j.Bookmarks{"bm-a"}) {
+		t.Errorf("bookmarksFor(%q) = %v, want %v", "db-a", got, neo4j.Bookmarks{"bm-a"})
+	}
+	if got := m.bookmarksFor("db-b"); !reflect.DeepEqual(got, neo4j.Bookmarks{"bm-b"}) {
+		t.Errorf("bookmarksFor(%q) = %v, want %v", "db-b", got, neo4j.Bookmarks{"bm-b"})
+	}
+}
+
+func TestBookmarkManagerNewSessionSeedsRecordedBookmarks(t *testing.T) {
+	driver := &fakeDriver{}
+	m := NewBookmarkManager(driver)
+
+	m.record("movies", neo4j.Bookmarks{"bm-1"})
+
+	_ = m.newSession(context.Background(), "movies")
+	if got := driver.lastCfg.DatabaseName; got != "movies" {
+		t.Errorf("DatabaseName = %q, want %q", got, "movies")
+	}
+	if !reflect.DeepEqual(driver.lastCfg.Bookmarks, neo4j.Bookmarks{"bm-1"}) {
+		t.Errorf("Bookmarks = %v, want %v", driver.lastCfg.Bookmarks, neo4j.Bookmarks{"bm-1"})
+	}
+
+	// A different database's session shouldn't be seeded with movies'
+	// bookmarks - this is the cross-contamination the review flagged.
+	_ = m.newSession(context.Background(), "other")
+	if len(driver.lastCfg.Bookmarks) != 0 {
+		t.Errorf("Bookmarks for unrelated database = %v, want none", driver.lastCfg.Bookmarks)
+	}
+	if got := driver.lastCfg.DatabaseName; got != "other" {
+		t.Errorf("DatabaseName = %q, want %q", got, "other")
+	}
+}
+
+func TestBookmarkManagerDefaultDatabaseLeavesDatabaseNameUnset(t *testing.T) {
+	driver := &fakeDriver{}
+	m := NewBookmarkManager(driver)
+
+	_ = m.newSession(context.Background(), "")
+	if driver.lastCfg.DatabaseName != "" {
+		t.Errorf("DatabaseName = %q, want empty (server default)", driver.lastCfg.DatabaseName)
+	}
+}
@@ -0,0 +1,65 @@
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// RunDemo is runBestPracticesExamples' counterpart for this package: it
+// bootstraps its own InstrumentedDriver the same way runBestPracticesExamples
+// bootstraps a plain one (best_practices.go is package main and, like every
+// other package in this repo, can't import a sibling package without a
+// module path to import it by), then runs Example 3's create-a-Person query
+// through an instrumented Session so the span/metric/log attributes above
+// have something to report on.
+func RunDemo() {
+	fmt.Println("\n=== neo4jx/otel: Instrumented ExecuteWrite ===")
+
+	driver, err := NewInstrumentedDriver(
+		"neo4j://localhost:7687",
+		neo4j.BasicAuth("neo4j", "Your@Password!@#", ""),
+	)
+	if err != nil {
+		panic(err)
+	}
+	defer driver.Close(context.Background())
+
+	ctx := context.Background()
+	if err := driver.VerifyConnectivity(ctx); err != nil {
+		panic(fmt.Sprintf("Failed to connect: %v", err))
+	}
+
+	session := driver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+
+	result, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx,
+			`CREATE (p:Person {name: $name, role: $role}) RETURN p`,
+			map[string]any{"name": "Otel Test Person", "role": "Developer"},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		record, err := result.Single(ctx)
+		if err != nil {
+			return nil, err
+		}
+		node, _ := record.Get("p")
+		return node, nil
+	})
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	person := result.(neo4j.Node)
+	fmt.Printf("✓ Created person: %s (role: %s), traced and recorded\n",
+		person.Props["name"], person.Props["role"])
+
+	session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return tx.Run(ctx, "MATCH (p:Person {name: 'Otel Test Person'}) DELETE p", nil)
+	})
+}
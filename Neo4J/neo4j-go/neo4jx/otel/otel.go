@@ -0,0 +1,240 @@
+// Package otel decorates neo4j.DriverWithContext and the session-level
+// ExecuteRead/ExecuteWrite calls with the observability none of the ten
+// best-practices examples have: an OpenTelemetry span per transaction,
+// Prometheus histograms for ResultAvailableAfter/ResultConsumedAfter, and a
+// log.BoltLogger that forwards bolt-level client/server messages into slog.
+package otel
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	boltlog "github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("neo4jx/otel")
+
+var (
+	resultAvailableAfter = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "neo4j_result_available_after_seconds",
+		Help:    "Seconds from query submission to the first result record becoming available (ResultSummary.ResultAvailableAfter).",
+		Buckets: prometheus.DefBuckets,
+	})
+	resultConsumedAfter = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "neo4j_result_consumed_after_seconds",
+		Help:    "Seconds from the first result record to the transaction finishing consuming it (ResultSummary.ResultConsumedAfter).",
+		Buckets: prometheus.DefBuckets,
+	})
+)
+
+func init() {
+	prometheus.MustRegister(resultAvailableAfter, resultConsumedAfter)
+}
+
+// Option configures an InstrumentedDriver or Session at construction time.
+type Option func(*config)
+
+type config struct {
+	tracer trace.Tracer
+	logger *slog.Logger
+}
+
+// WithTracer overrides the default "neo4jx/otel" tracer.
+func WithTracer(t trace.Tracer) Option {
+	return func(c *config) { c.tracer = t }
+}
+
+// WithLogger overrides the default slog.Default() destination for bolt
+// client/server messages.
+func WithLogger(l *slog.Logger) Option {
+	return func(c *config) { c.logger = l }
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{tracer: tracer, logger: slog.Default()}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// InstrumentedDriver wraps a neo4j.DriverWithContext so NewSession hands out
+// *Session values instead of plain neo4j.SessionWithContext ones. Every
+// other method (Close, VerifyConnectivity, ...) is promoted unchanged from
+// the embedded driver.
+type InstrumentedDriver struct {
+	neo4j.DriverWithContext
+	opts []Option
+}
+
+// NewInstrumentedDriver opens a driver against uri exactly as
+// neo4j.NewDriverWithContext does, and wraps the result so every session it
+// hands out is instrumented - including a log.BoltLogger that forwards
+// bolt-level client/server messages into slog (neo4j.Config has no
+// driver-wide BoltLogger slot; it's set per SessionConfig instead, which is
+// what InstrumentedDriver.NewSession/NewSession below do).
+func NewInstrumentedDriver(uri string, auth neo4j.AuthToken, opts ...Option) (*InstrumentedDriver, error) {
+	driver, err := neo4j.NewDriverWithContext(uri, auth)
+	if err != nil {
+		return nil, err
+	}
+	return &InstrumentedDriver{DriverWithContext: driver, opts: opts}, nil
+}
+
+// NewSession opens an instrumented session on d.
+func (d *InstrumentedDriver) NewSession(ctx context.Context, sessionCfg neo4j.SessionConfig) *Session {
+	return NewSession(ctx, d.DriverWithContext, sessionCfg, d.opts...)
+}
+
+// Session wraps a neo4j.SessionWithContext so every ExecuteRead/ExecuteWrite
+// emits an OpenTelemetry span (db.system, db.statement, db.neo4j.database,
+// retry count, bookmarks in/out) and records the Prometheus histograms
+// above from the transaction's ResultSummary.
+type Session struct {
+	inner       neo4j.SessionWithContext
+	database    string
+	bookmarksIn neo4j.Bookmarks
+	tracer      trace.Tracer
+}
+
+// NewSession opens a session on driver and wraps it for tracing and
+// metrics. cfg.Bookmarks, if any, is recorded as the span's bookmarks-in
+// attribute. Unless cfg.BoltLogger is already set, it's given a
+// slogBoltLogger forwarding bolt-level client/server messages into slog.
+func NewSession(ctx context.Context, driver neo4j.DriverWithContext, cfg neo4j.SessionConfig, opts ...Option) *Session {
+	c := newConfig(opts)
+	if cfg.BoltLogger == nil {
+		cfg.BoltLogger = &slogBoltLogger{logger: c.logger}
+	}
+	return &Session{
+		inner:       driver.NewSession(ctx, cfg),
+		database:    cfg.DatabaseName,
+		bookmarksIn: cfg.Bookmarks,
+		tracer:      c.tracer,
+	}
+}
+
+// Close closes the underlying session.
+func (s *Session) Close(ctx context.Context) error {
+	return s.inner.Close(ctx)
+}
+
+// ExecuteWrite runs work in a write transaction, wrapped in an OpenTelemetry
+// span with the attributes described on Session.
+func (s *Session) ExecuteWrite(ctx context.Context, work neo4j.ManagedTransactionWork, configurers ...func(*neo4j.TransactionConfig)) (any, error) {
+	return s.traced(ctx, true, work, configurers)
+}
+
+// ExecuteRead is ExecuteWrite's read-transaction counterpart.
+func (s *Session) ExecuteRead(ctx context.Context, work neo4j.ManagedTransactionWork, configurers ...func(*neo4j.TransactionConfig)) (any, error) {
+	return s.traced(ctx, false, work, configurers)
+}
+
+// attemptCounter is implemented by neo4jx.Session (and anything else that
+// tracks retry attempts), detected by duck typing rather than an import, so
+// this package doesn't need to know neo4jx's import path.
+type attemptCounter interface {
+	LastAttempts() int
+}
+
+func (s *Session) traced(
+	ctx context.Context,
+	write bool,
+	work neo4j.ManagedTransactionWork,
+	configurers []func(*neo4j.TransactionConfig),
+) (any, error) {
+	spanName := "neo4j.ExecuteRead"
+	if write {
+		spanName = "neo4j.ExecuteWrite"
+	}
+
+	ctx, span := s.tracer.Start(ctx, spanName)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "neo4j"),
+		attribute.String("db.neo4j.database", s.database),
+		attribute.Int("db.neo4j.bookmarks_in", len(s.bookmarksIn)),
+	)
+
+	tracedWork := func(tx neo4j.ManagedTransaction) (any, error) {
+		return work(&tracedTx{ManagedTransaction: tx, span: span})
+	}
+
+	var result any
+	var err error
+	if write {
+		result, err = s.inner.ExecuteWrite(ctx, tracedWork, configurers...)
+	} else {
+		result, err = s.inner.ExecuteRead(ctx, tracedWork, configurers...)
+	}
+
+	if ac, ok := s.inner.(attemptCounter); ok {
+		if attempts := ac.LastAttempts(); attempts > 0 {
+			span.SetAttributes(attribute.Int("db.neo4j.retry_count", attempts-1))
+		}
+	}
+	span.SetAttributes(attribute.Int("db.neo4j.bookmarks_out", len(s.inner.LastBookmarks())))
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
+
+// tracedTx wraps a neo4j.ManagedTransaction so the first Run call's
+// statement is attached to the enclosing span, and its ResultWithContext is
+// wrapped so a later Consume records the histograms above.
+type tracedTx struct {
+	neo4j.ManagedTransaction
+	span trace.Span
+}
+
+func (t *tracedTx) Run(ctx context.Context, cypher string, params map[string]any) (neo4j.ResultWithContext, error) {
+	t.span.SetAttributes(attribute.String("db.statement", cypher))
+
+	result, err := t.ManagedTransaction.Run(ctx, cypher, params)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedResult{ResultWithContext: result}, nil
+}
+
+type tracedResult struct {
+	neo4j.ResultWithContext
+}
+
+func (r *tracedResult) Consume(ctx context.Context) (neo4j.ResultSummary, error) {
+	summary, err := r.ResultWithContext.Consume(ctx)
+	if err != nil {
+		return summary, err
+	}
+	resultAvailableAfter.Observe(summary.ResultAvailableAfter().Seconds())
+	resultConsumedAfter.Observe(summary.ResultConsumedAfter().Seconds())
+	return summary, nil
+}
+
+// slogBoltLogger implements the driver's log.BoltLogger interface,
+// forwarding bolt-level client/server protocol messages into slog at debug
+// level instead of discarding them.
+type slogBoltLogger struct {
+	logger *slog.Logger
+}
+
+var _ boltlog.BoltLogger = (*slogBoltLogger)(nil)
+
+func (l *slogBoltLogger) LogClientMessage(logContext, msg string, args ...any) {
+	l.logger.Debug("bolt client message", "context", logContext, "msg", fmt.Sprintf(msg, args...))
+}
+
+func (l *slogBoltLogger) LogServerMessage(logContext, msg string, args ...any) {
+	l.logger.Debug("bolt server message", "context", logContext, "msg", fmt.Sprintf(msg, args...))
+}
@@ -0,0 +1,268 @@
+package neo4jx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// ErrorClass categorizes a Neo4j server error for retry purposes. Example 9
+// in best_practices.go just says ExecuteRead/ExecuteWrite "retry
+// automatically"; this gives callers visibility into, and control over,
+// what's actually retried.
+type ErrorClass int
+
+const (
+	// ClassUnknown is any error that isn't a *neo4j.Neo4jError - a network
+	// error, a context cancellation, a Go-side bug. Not retried.
+	ClassUnknown ErrorClass = iota
+	// ClassTransient is a Neo.TransientError.* - deadlocks, out-of-memory,
+	// lock acquisition timeouts. Retried.
+	ClassTransient
+	// ClassNotALeader is Neo.ClientError.Cluster.NotALeader - the routed-to
+	// member lost leadership mid-write. Retried (a fresh attempt re-routes).
+	ClassNotALeader
+	// ClassAuth is a Neo.ClientError.Security.* - credentials or
+	// authorization. Never retried.
+	ClassAuth
+	// ClassClientError is any other Neo.ClientError.* - bad Cypher, a
+	// missing constraint, a type mismatch. Never retried.
+	ClassClientError
+)
+
+func classify(err error) ErrorClass {
+	var neoErr *neo4j.Neo4jError
+	if !errors.As(err, &neoErr) {
+		return ClassUnknown
+	}
+
+	switch {
+	case strings.HasPrefix(neoErr.Code, "Neo.ClientError.Cluster.NotALeader"):
+		return ClassNotALeader
+	case strings.HasPrefix(neoErr.Code, "Neo.ClientError.Security"):
+		return ClassAuth
+	case strings.HasPrefix(neoErr.Code, "Neo.TransientError"):
+		return ClassTransient
+	case strings.HasPrefix(neoErr.Code, "Neo.ClientError"):
+		return ClassClientError
+	default:
+		return ClassUnknown
+	}
+}
+
+func (c ErrorClass) retryable() bool {
+	return c == ClassTransient || c == ClassNotALeader
+}
+
+// RetryPolicy configures exponential backoff with jitter for
+// ExecuteRead/ExecuteWrite on a *Session.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of tries, including the first.
+	MaxAttempts int
+	// InitialBackoff is the delay before the second attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between any two attempts.
+	MaxBackoff time.Duration
+	// Multiplier grows the backoff after each retryable failure.
+	Multiplier float64
+	// Jitter is the fraction (0-1) of the computed backoff to randomly add
+	// or subtract, to avoid synchronized retries across clients.
+	Jitter float64
+	// OnRetry, if set, is called after each retryable failure, before the
+	// backoff sleep.
+	OnRetry func(attempt int, err error, backoff time.Duration)
+}
+
+// DefaultRetryPolicy is a conservative policy: 5 attempts, starting at
+// 100ms and doubling up to a 5s cap, with 20% jitter.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+func (p RetryPolicy) backoffFor(attempt int) time.Duration {
+	backoff := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-1))
+	if backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		backoff += backoff * p.Jitter * (rand.Float64()*2 - 1)
+	}
+	if backoff < 0 {
+		backoff = 0
+	}
+	return time.Duration(backoff)
+}
+
+// Session wraps a pair of neo4j.SessionWithContext values - one opened
+// AccessModeRead, one AccessModeWrite, sharing a BookmarkManager so a read
+// still sees an earlier write and vice versa - with a RetryPolicy replacing
+// the driver's built-in (MaxTransactionRetryTime-only) retry with
+// configurable backoff and error classification.
+//
+// A single neo4j.SessionWithContext can't be used for this: BeginTransaction
+// always opens a transaction in the session's configured default
+// AccessMode, so routing ExecuteRead's transactions to read replicas
+// requires a session actually opened with AccessModeRead (see
+// sessionWithContext.BeginTransaction in the driver source, which passes
+// s.defaultMode rather than taking a per-call mode).
+type Session struct {
+	readSession  neo4j.SessionWithContext
+	writeSession neo4j.SessionWithContext
+	policy       RetryPolicy
+
+	lastAttempts int
+}
+
+// SessionOption configures a *Session at construction time.
+type SessionOption func(*Session)
+
+// WithRetryPolicy overrides the default retry policy.
+func WithRetryPolicy(policy RetryPolicy) SessionOption {
+	return func(s *Session) { s.policy = policy }
+}
+
+// NewSession opens a read session and a write session on driver, sharing
+// cfg.BookmarkManager (defaulted to a fresh one if cfg doesn't set it) for
+// causal consistency between them, and wraps both with a RetryPolicy
+// (DefaultRetryPolicy unless overridden via WithRetryPolicy). cfg.AccessMode
+// is ignored - each underlying session gets the mode its own calls need.
+func NewSession(ctx context.Context, driver neo4j.DriverWithContext, cfg neo4j.SessionConfig, opts ...SessionOption) *Session {
+	if cfg.BookmarkManager == nil {
+		cfg.BookmarkManager = neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{})
+	}
+
+	readCfg, writeCfg := cfg, cfg
+	readCfg.AccessMode = neo4j.AccessModeRead
+	writeCfg.AccessMode = neo4j.AccessModeWrite
+
+	s := &Session{
+		readSession:  driver.NewSession(ctx, readCfg),
+		writeSession: driver.NewSession(ctx, writeCfg),
+		policy:       DefaultRetryPolicy(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Close closes both underlying sessions, returning the first error (if any)
+// but always attempting both.
+func (s *Session) Close(ctx context.Context) error {
+	writeErr := s.writeSession.Close(ctx)
+	readErr := s.readSession.Close(ctx)
+	if writeErr != nil {
+		return writeErr
+	}
+	return readErr
+}
+
+// LastBookmarks returns the write session's bookmarks, which - thanks to the
+// shared BookmarkManager - reflect every write either session has committed.
+func (s *Session) LastBookmarks() neo4j.Bookmarks {
+	return s.writeSession.LastBookmarks()
+}
+
+// LastAttempts is how many transaction attempts the most recent
+// ExecuteWrite/ExecuteRead call took, including the one that finally
+// succeeded or gave up. It's not safe to read concurrently with another
+// call on the same Session.
+func (s *Session) LastAttempts() int {
+	return s.lastAttempts
+}
+
+// ExecuteWrite runs work in an explicit transaction on the write session,
+// retrying classify(err)-retryable failures per s.policy, instead of
+// relying on the driver's own MaxTransactionRetryTime-bounded retry. It has
+// the same signature as neo4j.SessionWithContext.ExecuteWrite, so the
+// package-level generic ExecuteWrite[T]/ExecuteRead[T] work against a
+// *Session exactly as they do against a plain session.
+func (s *Session) ExecuteWrite(ctx context.Context, work neo4j.ManagedTransactionWork, _ ...func(*neo4j.TransactionConfig)) (any, error) {
+	return s.executeWithRetry(ctx, s.writeSession, work)
+}
+
+// ExecuteRead is ExecuteWrite's counterpart, running work on the read
+// session.
+func (s *Session) ExecuteRead(ctx context.Context, work neo4j.ManagedTransactionWork, _ ...func(*neo4j.TransactionConfig)) (any, error) {
+	return s.executeWithRetry(ctx, s.readSession, work)
+}
+
+func (s *Session) executeWithRetry(ctx context.Context, sess neo4j.SessionWithContext, work neo4j.ManagedTransactionWork) (any, error) {
+	value, attempts, err := runWithRetry(ctx, s.policy, func() (any, error) {
+		tx, err := sess.BeginTransaction(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("neo4jx: begin transaction: %w", err)
+		}
+
+		value, err := work(tx)
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return nil, err
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return nil, err
+		}
+		return value, nil
+	})
+	s.lastAttempts = attempts
+	return value, err
+}
+
+// runWithRetry is the transport-agnostic core of Session's retry loop: it
+// calls attempt up to policy.MaxAttempts times, sleeping policy.backoffFor
+// between classify(err)-retryable failures, and returns the attempt count
+// alongside the eventual result. Pulling this out of executeWithRetry lets
+// retry_test.go verify backoff and give-up behavior against a fake attempt
+// func that fails a chosen number of times, without needing a fake
+// neo4j.SessionWithContext or neo4j.ManagedTransaction - both of those
+// driver interfaces have unexported methods (e.g. ManagedTransaction.legacy)
+// that only the driver's own package can implement, so there's no faking
+// them from outside.
+func runWithRetry(ctx context.Context, policy RetryPolicy, attempt func() (any, error)) (value any, attempts int, err error) {
+	var lastErr error
+
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+
+	for i := 1; i <= maxAttempts; i++ {
+		attempts = i
+
+		value, err := attempt()
+		if err == nil {
+			return value, attempts, nil
+		}
+		lastErr = err
+
+		class := classify(lastErr)
+		if !class.retryable() || i == maxAttempts {
+			return nil, attempts, lastErr
+		}
+
+		backoff := policy.backoffFor(i)
+		if policy.OnRetry != nil {
+			policy.OnRetry(i, lastErr, backoff)
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, attempts, ctx.Err()
+		}
+	}
+
+	return nil, attempts, lastErr
+}
@@ -0,0 +1,122 @@
+package neo4jx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// transientErr builds a synthetic Neo.TransientError, the class runWithRetry
+// is expected to retry.
+func transientErr() error {
+	return &neo4j.Neo4jError{Code: "Neo.TransientError.Transaction.DeadlockDetected", Msg: "deadlock"}
+}
+
+// authErr builds a synthetic Neo.ClientError.Security error, never retried.
+func authErr() error {
+	return &neo4j.Neo4jError{Code: "Neo.ClientError.Security.Unauthorized", Msg: "nope"}
+}
+
+func TestRunWithRetrySucceedsAfterTransientFailures(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond, Multiplier: 2}
+
+	calls := 0
+	var retries []int
+	policy.OnRetry = func(attempt int, err error, backoff time.Duration) {
+		retries = append(retries, attempt)
+	}
+
+	value, attempts, err := runWithRetry(context.Background(), policy, func() (any, error) {
+		calls++
+		if calls < 3 {
+			return nil, transientErr()
+		}
+		return "ok", nil
+	})
+	if err != nil {
+		t.Fatalf("runWithRetry: %v", err)
+	}
+	if value != "ok" {
+		t.Errorf("value = %v, want %q", value, "ok")
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+	if want := []int{1, 2}; !equalInts(retries, want) {
+		t.Errorf("OnRetry called with attempts %v, want %v", retries, want)
+	}
+}
+
+func TestRunWithRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Multiplier: 1}
+
+	calls := 0
+	_, attempts, err := runWithRetry(context.Background(), policy, func() (any, error) {
+		calls++
+		return nil, transientErr()
+	})
+	if err == nil {
+		t.Fatal("runWithRetry: expected error after exhausting retries, got nil")
+	}
+	if calls != 3 {
+		t.Errorf("attempt func called %d times, want 3", calls)
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestRunWithRetryDoesNotRetryNonTransientErrors(t *testing.T) {
+	policy := DefaultRetryPolicy()
+
+	calls := 0
+	_, attempts, err := runWithRetry(context.Background(), policy, func() (any, error) {
+		calls++
+		return nil, authErr()
+	})
+	if err == nil {
+		t.Fatal("runWithRetry: expected error, got nil")
+	}
+	if calls != 1 {
+		t.Errorf("attempt func called %d times, want 1 (auth errors aren't retryable)", calls)
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1", attempts)
+	}
+}
+
+func TestRunWithRetryStopsOnContextCancellation(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, InitialBackoff: 50 * time.Millisecond, MaxBackoff: 50 * time.Millisecond, Multiplier: 1}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	_, _, err := runWithRetry(ctx, policy, func() (any, error) {
+		calls++
+		if calls == 1 {
+			cancel()
+		}
+		return nil, transientErr()
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+	if calls != 1 {
+		t.Errorf("attempt func called %d times, want 1 (should stop at the cancellation)", calls)
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,163 @@
+package neo4jx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Scan drains result, binding each record onto a new T via `neo4j:"..."`
+// struct tags - the same vocabulary as the bind package's
+// StructResultTransformer:
+//
+//	`neo4j:"actor"`          - scalar column "actor"
+//	`neo4j:"m,node"`         - column "m" is a neo4j.Node; its properties
+//	                           populate the tagged field's own struct, whose
+//	                           fields use `neo4j:"prop=title"`
+//	`neo4j:"r,relationship"` - same, for a neo4j.Relationship
+//
+// Scan is the cursor-based counterpart for call sites that already have a
+// live neo4j.ResultWithContext (e.g. from tx.Run) rather than an
+// EagerResult, so queryAllMovies-style code becomes
+// `neo4jx.Scan[Movie](ctx, result)` instead of a manual record.Get + cast
+// loop.
+func Scan[T any](ctx context.Context, result neo4j.ResultWithContext) ([]T, error) {
+	var rows []T
+	for result.Next(ctx) {
+		var row T
+		if err := bindRecord(result.Record(), &row); err != nil {
+			return nil, fmt.Errorf("neo4jx: %w", err)
+		}
+		rows = append(rows, row)
+	}
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+type fieldTag struct {
+	column string
+	kind   string // "", "node", "relationship"
+	isProp bool
+}
+
+func parseTag(raw string) (fieldTag, bool) {
+	if raw == "" || raw == "-" {
+		return fieldTag{}, false
+	}
+	parts := strings.Split(raw, ",")
+	if name, ok := strings.CutPrefix(parts[0], "prop="); ok {
+		return fieldTag{column: name, isProp: true}, true
+	}
+	tag := fieldTag{column: parts[0]}
+	if len(parts) > 1 {
+		tag.kind = parts[1]
+	}
+	return tag, true
+}
+
+func bindRecord(record *neo4j.Record, dst any) error {
+	v := reflect.ValueOf(dst).Elem()
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		tag, ok := parseTag(t.Field(i).Tag.Get("neo4j"))
+		if !ok {
+			continue
+		}
+
+		raw, found := record.Get(tag.column)
+		if !found || raw == nil {
+			continue
+		}
+
+		fv := v.Field(i)
+		switch tag.kind {
+		case "node":
+			node, ok := raw.(neo4j.Node)
+			if !ok {
+				return fmt.Errorf("column %q: expected neo4j.Node, got %T", tag.column, raw)
+			}
+			if err := bindProps(node.Props, fv); err != nil {
+				return fmt.Errorf("column %q: %w", tag.column, err)
+			}
+		case "relationship":
+			rel, ok := raw.(neo4j.Relationship)
+			if !ok {
+				return fmt.Errorf("column %q: expected neo4j.Relationship, got %T", tag.column, raw)
+			}
+			if err := bindProps(rel.Props, fv); err != nil {
+				return fmt.Errorf("column %q: %w", tag.column, err)
+			}
+		default:
+			if err := setValue(fv, raw); err != nil {
+				return fmt.Errorf("column %q: %w", tag.column, err)
+			}
+		}
+	}
+	return nil
+}
+
+func bindProps(props map[string]any, fv reflect.Value) error {
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		fv = fv.Elem()
+	}
+	if fv.Kind() != reflect.Struct {
+		return fmt.Errorf("destination field must be a struct, got %s", fv.Kind())
+	}
+
+	ft := fv.Type()
+	for i := 0; i < ft.NumField(); i++ {
+		tag, ok := parseTag(ft.Field(i).Tag.Get("neo4j"))
+		if !ok || !tag.isProp {
+			continue
+		}
+		raw, present := props[tag.column]
+		if !present || raw == nil {
+			continue
+		}
+		if err := setValue(fv.Field(i), raw); err != nil {
+			return fmt.Errorf("property %q: %w", tag.column, err)
+		}
+	}
+	return nil
+}
+
+// setValue assigns raw onto dst, converting a []any list column (e.g. from
+// `collect(...)`) into a same-kind Go slice when dst is a slice type.
+func setValue(dst reflect.Value, raw any) error {
+	rv := reflect.ValueOf(raw)
+
+	if dst.Kind() == reflect.Slice && rv.Kind() == reflect.Slice {
+		out := reflect.MakeSlice(dst.Type(), rv.Len(), rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			elem := reflect.ValueOf(rv.Index(i).Interface())
+			if !elem.Type().AssignableTo(dst.Type().Elem()) {
+				if !elem.Type().ConvertibleTo(dst.Type().Elem()) {
+					return fmt.Errorf("element %d: cannot assign %s to %s", i, elem.Type(), dst.Type().Elem())
+				}
+				elem = elem.Convert(dst.Type().Elem())
+			}
+			out.Index(i).Set(elem)
+		}
+		dst.Set(out)
+		return nil
+	}
+
+	if rv.Type().AssignableTo(dst.Type()) {
+		dst.Set(rv)
+		return nil
+	}
+	if rv.Type().ConvertibleTo(dst.Type()) {
+		dst.Set(rv.Convert(dst.Type()))
+		return nil
+	}
+	return fmt.Errorf("cannot assign %T to %s", raw, dst.Type())
+}
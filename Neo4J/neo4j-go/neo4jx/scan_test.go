@@ -0,0 +1,104 @@
+package neo4jx
+
+import (
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestBindRecordScalar(t *testing.T) {
+	type row struct {
+		Title    string `neo4j:"title"`
+		Released int64  `neo4j:"released"`
+	}
+
+	record := &neo4j.Record{
+		Keys:   []string{"title", "released"},
+		Values: []any{"The Matrix", int64(1999)},
+	}
+
+	var got row
+	if err := bindRecord(record, &got); err != nil {
+		t.Fatalf("bindRecord: %v", err)
+	}
+	want := row{Title: "The Matrix", Released: 1999}
+	if got != want {
+		t.Errorf("bindRecord() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBindRecordNode(t *testing.T) {
+	type personProps struct {
+		Name string `neo4j:"prop=name"`
+		Born int64  `neo4j:"prop=born"`
+	}
+	type row struct {
+		Person personProps `neo4j:"p,node"`
+	}
+
+	record := &neo4j.Record{
+		Keys: []string{"p"},
+		Values: []any{
+			neo4j.Node{Props: map[string]any{"name": "Keanu Reeves", "born": int64(1964)}},
+		},
+	}
+
+	var got row
+	if err := bindRecord(record, &got); err != nil {
+		t.Fatalf("bindRecord: %v", err)
+	}
+	want := row{Person: personProps{Name: "Keanu Reeves", Born: 1964}}
+	if got != want {
+		t.Errorf("bindRecord() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBindRecordRelationship(t *testing.T) {
+	type actedInProps struct {
+		Role string `neo4j:"prop=role"`
+	}
+	type row struct {
+		ActedIn actedInProps `neo4j:"r,relationship"`
+	}
+
+	record := &neo4j.Record{
+		Keys: []string{"r"},
+		Values: []any{
+			neo4j.Relationship{Props: map[string]any{"role": "Neo"}},
+		},
+	}
+
+	var got row
+	if err := bindRecord(record, &got); err != nil {
+		t.Fatalf("bindRecord: %v", err)
+	}
+	want := row{ActedIn: actedInProps{Role: "Neo"}}
+	if got != want {
+		t.Errorf("bindRecord() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBindRecordList(t *testing.T) {
+	type row struct {
+		Titles []string `neo4j:"titles"`
+	}
+
+	record := &neo4j.Record{
+		Keys:   []string{"titles"},
+		Values: []any{[]any{"The Matrix", "John Wick"}},
+	}
+
+	var got row
+	if err := bindRecord(record, &got); err != nil {
+		t.Fatalf("bindRecord: %v", err)
+	}
+	want := row{Titles: []string{"The Matrix", "John Wick"}}
+	if len(got.Titles) != len(want.Titles) {
+		t.Fatalf("bindRecord() = %+v, want %+v", got, want)
+	}
+	for i := range want.Titles {
+		if got.Titles[i] != want.Titles[i] {
+			t.Errorf("bindRecord() = %+v, want %+v", got, want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package neo4jx
+
+import (
+	"context"
+	"iter"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// streamFetchSize is the default SessionConfig.FetchSize for Stream and
+// StreamChan: small enough that a MATCH over an LDBC/offshore-leaks-scale
+// dataset never holds more than a few thousand records in memory at once,
+// unlike queryAllMovies's neo4j.EagerResultTransformer, which buffers every
+// row.
+const streamFetchSize = 1000
+
+// Stream runs cypher as an explicit (unmanaged) read transaction against
+// driver and returns a Go 1.23 range-over-func iterator that decodes and
+// yields one record at a time, pulling more from the server in batches of
+// streamFetchSize as the caller drains it. Breaking out of the range loop,
+// or ctx being canceled, aborts the underlying transaction instead of
+// reading it to completion.
+//
+// This deliberately uses BeginTransaction rather than session.ExecuteRead:
+// ExecuteRead retries its whole work function on a retryable error, and a
+// retry here would re-run tx.Run and yield every record already sent to the
+// caller a second time. A single streaming pass can't un-yield what it's
+// already handed out, so Stream runs the transaction once and surfaces a
+// transient failure as a terminal error instead of masking it with a silent
+// replay.
+func Stream[T any](ctx context.Context, driver neo4j.DriverWithContext, cypher string, params map[string]any, decode func(*neo4j.Record) (T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		session := driver.NewSession(ctx, neo4j.SessionConfig{FetchSize: streamFetchSize})
+		defer session.Close(ctx)
+
+		tx, err := session.BeginTransaction(ctx)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+
+		result, err := tx.Run(ctx, cypher, params)
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			yield(zero, err)
+			return
+		}
+
+		for result.Next(ctx) {
+			value, err := decode(result.Record())
+			if err != nil {
+				_ = tx.Rollback(ctx)
+				yield(zero, err)
+				return
+			}
+			if !yield(value, nil) {
+				_ = tx.Rollback(ctx)
+				return
+			}
+			if ctx.Err() != nil {
+				_ = tx.Rollback(ctx)
+				yield(zero, ctx.Err())
+				return
+			}
+		}
+
+		if err := result.Err(); err != nil {
+			_ = tx.Rollback(ctx)
+			yield(zero, err)
+			return
+		}
+		if err := tx.Commit(ctx); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+// StreamChan is Stream's channel-based counterpart for producer/consumer
+// pipelines: it runs the same managed read transaction in a goroutine,
+// sending each decoded value (or the first decode/query error) on the
+// returned channel, which is closed when the result is exhausted, ctx is
+// canceled, or the consumer stops receiving. buffer sizes the channel so a
+// slow consumer applies backpressure to the pull from the server rather
+// than the producer racing ahead unbounded.
+func StreamChan[T any](ctx context.Context, driver neo4j.DriverWithContext, cypher string, params map[string]any, decode func(*neo4j.Record) (T, error), buffer int) <-chan Streamed[T] {
+	out := make(chan Streamed[T], buffer)
+
+	go func() {
+		defer close(out)
+		for value, err := range Stream(ctx, driver, cypher, params, decode) {
+			select {
+			case out <- Streamed[T]{Value: value, Err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Streamed is one element of a StreamChan: either a decoded value, or the
+// terminal error that stopped the stream.
+type Streamed[T any] struct {
+	Value T
+	Err   error
+}
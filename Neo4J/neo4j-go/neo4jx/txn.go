@@ -0,0 +1,49 @@
+package neo4jx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// txExecutor is satisfied by both neo4j.SessionWithContext and *Session, so
+// ExecuteWrite/ExecuteRead work the same whether or not the session has a
+// RetryPolicy attached.
+type txExecutor interface {
+	ExecuteWrite(ctx context.Context, work neo4j.ManagedTransactionWork, configurers ...func(*neo4j.TransactionConfig)) (any, error)
+	ExecuteRead(ctx context.Context, work neo4j.ManagedTransactionWork, configurers ...func(*neo4j.TransactionConfig)) (any, error)
+}
+
+// ExecuteWrite runs fn in a write transaction on session and type-asserts
+// its result to T, so call sites no longer need `result.(neo4j.Node)` or
+// similar after every session.ExecuteWrite.
+func ExecuteWrite[T any](ctx context.Context, session txExecutor, fn func(neo4j.ManagedTransaction) (T, error)) (T, error) {
+	return executeTyped(ctx, fn, session.ExecuteWrite)
+}
+
+// ExecuteRead is ExecuteWrite's read-transaction counterpart.
+func ExecuteRead[T any](ctx context.Context, session txExecutor, fn func(neo4j.ManagedTransaction) (T, error)) (T, error) {
+	return executeTyped(ctx, fn, session.ExecuteRead)
+}
+
+func executeTyped[T any](
+	ctx context.Context,
+	fn func(neo4j.ManagedTransaction) (T, error),
+	execute func(context.Context, neo4j.ManagedTransactionWork, ...func(*neo4j.TransactionConfig)) (any, error),
+) (T, error) {
+	var zero T
+
+	raw, err := execute(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return fn(tx)
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	typed, ok := raw.(T)
+	if !ok {
+		return zero, fmt.Errorf("neo4jx: expected %T, got %T", zero, raw)
+	}
+	return typed, nil
+}
@@ -6,20 +6,17 @@ import (
 	"log"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/rupeedev/ai-pack/neo4j-go/internal/config"
 )
 
 func queryAllMovies() {
 	// Create a driver instance
-	driver, err := neo4j.NewDriverWithContext(
-		"neo4j://localhost:7687",
-		neo4j.BasicAuth("neo4j", "Your@Password!@#", ""),
-	)
+	ctx := context.Background()
+	driver, err := config.DriverFromEnv(ctx)
 	if err != nil {
 		log.Fatal(err)
 	}
-	defer driver.Close(context.Background())
-
-	ctx := context.Background()
+	defer driver.Close(ctx)
 
 	// Execute query to get all movies
 	result, err := neo4j.ExecuteQuery(ctx, driver,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/rupeedev/ai-pack/neo4j-go/internal/config"
 )
 
 // Example 1: Working with Nodes
@@ -399,22 +400,12 @@ func showTypeMappings(ctx context.Context, driver neo4j.DriverWithContext) {
 
 func runResultHandlingExamples() {
 	// Setup driver
-	driver, err := neo4j.NewDriverWithContext(
-		"neo4j://localhost:7687",
-		neo4j.BasicAuth("neo4j", "Your@Password!@#", ""),
-	)
-	if err != nil {
-		panic(err)
-	}
-	defer driver.Close(context.Background())
-
 	ctx := context.Background()
-
-	// Verify connection
-	err = driver.VerifyConnectivity(ctx)
+	driver, err := config.DriverFromEnv(ctx)
 	if err != nil {
-		panic(fmt.Sprintf("Failed to connect: %v", err))
+		panic(err)
 	}
+	defer driver.Close(ctx)
 
 	fmt.Println("Connected to Neo4j successfully!")
 	fmt.Println("========================================")
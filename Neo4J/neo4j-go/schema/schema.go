@@ -0,0 +1,290 @@
+// Package schema gives this project's examples a first-class, version
+// tracked way to declare the constraints and indexes they currently never
+// create. Migrations are recorded in ":_Migration {id, appliedAt}" nodes so
+// re-running Migrate is idempotent.
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+type opKind int
+
+const (
+	kindUnique opKind = iota
+	kindNodeKey
+	kindRangeIndex
+	kindFulltext
+	kindVector
+)
+
+// Op is a single schema operation: a constraint or index to create.
+type Op struct {
+	kind       opKind
+	name       string
+	label      string
+	labels     []string
+	props      []string
+	dimensions int
+	similarity string
+}
+
+// UniqueNodeConstraint declares "FOR (n:label) REQUIRE n.prop IS UNIQUE".
+func UniqueNodeConstraint(label, prop string) Op {
+	return Op{kind: kindUnique, label: label, props: []string{prop}, name: autoName("unique", label, prop)}
+}
+
+// NodeKey declares "FOR (n:label) REQUIRE (n.p1, n.p2, ...) IS NODE KEY".
+func NodeKey(label string, props ...string) Op {
+	return Op{kind: kindNodeKey, label: label, props: props, name: autoName("nodekey", label, props...)}
+}
+
+// RangeIndex declares a single-property range index.
+func RangeIndex(label, prop string) Op {
+	return Op{kind: kindRangeIndex, label: label, props: []string{prop}, name: autoName("range", label, prop)}
+}
+
+// FulltextIndex declares a fulltext index over one or more labels/properties.
+func FulltextIndex(name string, labels, props []string) Op {
+	return Op{kind: kindFulltext, name: name, labels: labels, props: props}
+}
+
+// VectorIndex declares a vector index for similarity search, e.g. over
+// embeddings stored on a node property.
+func VectorIndex(name, label, prop string, dimensions int, similarity string) Op {
+	return Op{kind: kindVector, name: name, label: label, props: []string{prop}, dimensions: dimensions, similarity: similarity}
+}
+
+func autoName(kind, label string, props ...string) string {
+	return strings.ToLower(fmt.Sprintf("%s_%s_%s", kind, label, strings.Join(props, "_")))
+}
+
+// Cypher renders the "CREATE ... IF NOT EXISTS" statement for this op.
+func (o Op) Cypher() string {
+	switch o.kind {
+	case kindUnique:
+		return fmt.Sprintf("CREATE CONSTRAINT %s IF NOT EXISTS FOR (n:%s) REQUIRE n.%s IS UNIQUE",
+			o.name, o.label, o.props[0])
+	case kindNodeKey:
+		return fmt.Sprintf("CREATE CONSTRAINT %s IF NOT EXISTS FOR (n:%s) REQUIRE (%s) IS NODE KEY",
+			o.name, o.label, joinProps(o.props))
+	case kindRangeIndex:
+		return fmt.Sprintf("CREATE RANGE INDEX %s IF NOT EXISTS FOR (n:%s) ON (n.%s)",
+			o.name, o.label, o.props[0])
+	case kindFulltext:
+		return fmt.Sprintf("CREATE FULLTEXT INDEX %s IF NOT EXISTS FOR (n:%s) ON EACH [%s]",
+			o.name, strings.Join(o.labels, "|"), joinEach(o.props))
+	case kindVector:
+		return fmt.Sprintf(
+			"CREATE VECTOR INDEX %s IF NOT EXISTS FOR (n:%s) ON (n.%s) OPTIONS {indexConfig: {`vector.dimensions`: %d, `vector.similarity_function`: '%s'}}",
+			o.name, o.label, o.props[0], o.dimensions, o.similarity)
+	default:
+		return ""
+	}
+}
+
+func joinProps(props []string) string {
+	prefixed := make([]string, len(props))
+	for i, p := range props {
+		prefixed[i] = "n." + p
+	}
+	return strings.Join(prefixed, ", ")
+}
+
+func joinEach(props []string) string {
+	return joinProps(props)
+}
+
+// signature identifies an Op by the label(s)/property set it targets,
+// independent of the exact constraint or index name, so it can be matched
+// against an introspected Snapshot.
+func (o Op) signature() string {
+	labels := o.labels
+	if len(labels) == 0 && o.label != "" {
+		labels = []string{o.label}
+	}
+	return signatureOf(labels, o.props)
+}
+
+func signatureOf(labels, props []string) string {
+	labels = append([]string(nil), labels...)
+	props = append([]string(nil), props...)
+	sort.Strings(labels)
+	sort.Strings(props)
+	return strings.Join(labels, "|") + "::" + strings.Join(props, "|")
+}
+
+// Migration is a named, ordered set of schema operations.
+type Migration struct {
+	ID          string
+	Description string
+	Ops         []Op
+}
+
+// Migrate applies each migration not already recorded as applied, in order,
+// recording it once all of its ops succeed.
+func Migrate(ctx context.Context, driver neo4j.DriverWithContext, migrations []Migration) error {
+	if err := ensureMigrationConstraint(ctx, driver); err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		applied, err := isApplied(ctx, driver, m.ID)
+		if err != nil {
+			return fmt.Errorf("schema: check migration %s: %w", m.ID, err)
+		}
+		if applied {
+			continue
+		}
+
+		for _, op := range m.Ops {
+			if _, err := neo4j.ExecuteQuery(ctx, driver, op.Cypher(), nil, neo4j.EagerResultTransformer); err != nil {
+				return fmt.Errorf("schema: migration %s: %w", m.ID, err)
+			}
+		}
+
+		if err := recordApplied(ctx, driver, m.ID); err != nil {
+			return fmt.Errorf("schema: record migration %s: %w", m.ID, err)
+		}
+	}
+	return nil
+}
+
+func ensureMigrationConstraint(ctx context.Context, driver neo4j.DriverWithContext) error {
+	_, err := neo4j.ExecuteQuery(ctx, driver,
+		"CREATE CONSTRAINT schema_migration_id IF NOT EXISTS FOR (m:_Migration) REQUIRE m.id IS UNIQUE",
+		nil, neo4j.EagerResultTransformer)
+	return err
+}
+
+func isApplied(ctx context.Context, driver neo4j.DriverWithContext, id string) (bool, error) {
+	result, err := neo4j.ExecuteQuery(ctx, driver,
+		"MATCH (m:_Migration {id: $id}) RETURN count(m) AS count",
+		map[string]any{"id": id}, neo4j.EagerResultTransformer)
+	if err != nil {
+		return false, err
+	}
+	if len(result.Records) == 0 {
+		return false, nil
+	}
+	count, _ := result.Records[0].Get("count")
+	n, _ := count.(int64)
+	return n > 0, nil
+}
+
+func recordApplied(ctx context.Context, driver neo4j.DriverWithContext, id string) error {
+	_, err := neo4j.ExecuteQuery(ctx, driver,
+		"CREATE (:_Migration {id: $id, appliedAt: datetime()})",
+		map[string]any{"id": id}, neo4j.EagerResultTransformer)
+	return err
+}
+
+// ConstraintInfo is one row of SHOW CONSTRAINTS.
+type ConstraintInfo struct {
+	Name          string
+	Type          string
+	EntityType    string
+	LabelsOrTypes []string
+	Properties    []string
+}
+
+// IndexInfo is one row of SHOW INDEXES.
+type IndexInfo struct {
+	Name          string
+	Type          string
+	EntityType    string
+	LabelsOrTypes []string
+	Properties    []string
+}
+
+// Snapshot is the schema currently present in the database.
+type Snapshot struct {
+	Constraints []ConstraintInfo
+	Indexes     []IndexInfo
+}
+
+// Introspect returns the constraints and indexes currently defined.
+func Introspect(ctx context.Context, driver neo4j.DriverWithContext) (Snapshot, error) {
+	var snap Snapshot
+
+	constraints, err := neo4j.ExecuteQuery(ctx, driver, "SHOW CONSTRAINTS", nil, neo4j.EagerResultTransformer)
+	if err != nil {
+		return snap, fmt.Errorf("schema: SHOW CONSTRAINTS: %w", err)
+	}
+	for _, record := range constraints.Records {
+		snap.Constraints = append(snap.Constraints, ConstraintInfo{
+			Name:          stringOf(record, "name"),
+			Type:          stringOf(record, "type"),
+			EntityType:    stringOf(record, "entityType"),
+			LabelsOrTypes: stringSliceOf(record, "labelsOrTypes"),
+			Properties:    stringSliceOf(record, "properties"),
+		})
+	}
+
+	indexes, err := neo4j.ExecuteQuery(ctx, driver, "SHOW INDEXES", nil, neo4j.EagerResultTransformer)
+	if err != nil {
+		return snap, fmt.Errorf("schema: SHOW INDEXES: %w", err)
+	}
+	for _, record := range indexes.Records {
+		snap.Indexes = append(snap.Indexes, IndexInfo{
+			Name:          stringOf(record, "name"),
+			Type:          stringOf(record, "type"),
+			EntityType:    stringOf(record, "entityType"),
+			LabelsOrTypes: stringSliceOf(record, "labelsOrTypes"),
+			Properties:    stringSliceOf(record, "properties"),
+		})
+	}
+
+	return snap, nil
+}
+
+// Plan diffs desired against the live schema and returns the ops that still
+// need to be applied, so callers can preview before writing.
+func Plan(ctx context.Context, driver neo4j.DriverWithContext, desired []Op) ([]Op, error) {
+	snap, err := Introspect(ctx, driver)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := map[string]bool{}
+	for _, c := range snap.Constraints {
+		existing[signatureOf(c.LabelsOrTypes, c.Properties)] = true
+	}
+	for _, i := range snap.Indexes {
+		existing[signatureOf(i.LabelsOrTypes, i.Properties)] = true
+	}
+
+	var toApply []Op
+	for _, op := range desired {
+		if !existing[op.signature()] {
+			toApply = append(toApply, op)
+		}
+	}
+	return toApply, nil
+}
+
+func stringOf(record *neo4j.Record, key string) string {
+	v, _ := record.Get(key)
+	s, _ := v.(string)
+	return s
+}
+
+func stringSliceOf(record *neo4j.Record, key string) []string {
+	v, _ := record.Get(key)
+	raw, ok := v.([]any)
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(raw))
+	for _, e := range raw {
+		if s, ok := e.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
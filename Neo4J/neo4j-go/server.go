@@ -0,0 +1,238 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Role is one person's acting credit on a movie.
+type Role struct {
+	Actor string `json:"actor"`
+	Role  string `json:"role"`
+}
+
+// Movie is the JSON shape returned by GET /movie/{title}.
+type Movie struct {
+	Title    string `json:"title"`
+	Released int64  `json:"released"`
+	Tagline  string `json:"tagline,omitempty"`
+	Cast     []Role `json:"cast"`
+}
+
+// Person is the JSON shape returned by GET /person/{name}.
+type Person struct {
+	Name   string   `json:"name"`
+	Born   int64    `json:"born,omitempty"`
+	Movies []string `json:"movies"`
+}
+
+// runServer starts the REST/JSON API that exposes the sample movie graph,
+// turning this repo from a set of standalone runners into a demoable
+// service. It reuses the driver passed in from main and blocks until the
+// server stops or ctx is cancelled.
+func runServer(ctx context.Context, driver neo4j.DriverWithContext, addr string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /movie/{title}", getMovieHandler(driver))
+	mux.HandleFunc("GET /person/{name}", getPersonHandler(driver))
+	mux.HandleFunc("GET /search", searchHandler(driver))
+	mux.HandleFunc("POST /movie", createMovieHandler(driver))
+
+	server := &http.Server{Addr: addr, Handler: mux}
+
+	fmt.Printf("\n=== Movie graph API listening on %s ===\n", addr)
+	fmt.Println("  GET  /movie/{title}")
+	fmt.Println("  GET  /person/{name}")
+	fmt.Println("  GET  /search?q=...")
+	fmt.Println("  POST /movie")
+
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func getMovieHandler(driver neo4j.DriverWithContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		title := r.PathValue("title")
+
+		result, err := neo4j.ExecuteQuery(r.Context(), driver, `
+			MATCH (m:Movie {title: $title})
+			OPTIONAL MATCH (p:Person)-[rel:ACTED_IN]->(m)
+			RETURN m.title AS title, m.released AS released, m.tagline AS tagline,
+			       collect(CASE WHEN p IS NULL THEN null ELSE {actor: p.name, role: rel.role} END) AS cast
+		`,
+			map[string]any{"title": title},
+			neo4j.EagerResultTransformer,
+			neo4j.ExecuteQueryWithReadersRouting(),
+		)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		if len(result.Records) == 0 {
+			writeError(w, http.StatusNotFound, fmt.Errorf("movie %q not found", title))
+			return
+		}
+
+		writeJSON(w, http.StatusOK, movieFromRecord(result.Records[0]))
+	}
+}
+
+func getPersonHandler(driver neo4j.DriverWithContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := r.PathValue("name")
+
+		result, err := neo4j.ExecuteQuery(r.Context(), driver, `
+			MATCH (p:Person {name: $name})
+			OPTIONAL MATCH (p)-[:ACTED_IN]->(m:Movie)
+			RETURN p.name AS name, p.born AS born, collect(m.title) AS movies
+		`,
+			map[string]any{"name": name},
+			neo4j.EagerResultTransformer,
+			neo4j.ExecuteQueryWithReadersRouting(),
+		)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		if len(result.Records) == 0 {
+			writeError(w, http.StatusNotFound, fmt.Errorf("person %q not found", name))
+			return
+		}
+
+		record := result.Records[0]
+		nameVal, _ := record.Get("name")
+		bornVal, _ := record.Get("born")
+		moviesVal, _ := record.Get("movies")
+
+		born, _ := bornVal.(int64)
+		person := Person{Name: fmt.Sprint(nameVal), Born: born, Movies: stringsFrom(moviesVal)}
+
+		writeJSON(w, http.StatusOK, person)
+	}
+}
+
+func searchHandler(driver neo4j.DriverWithContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query().Get("q")
+		if q == "" {
+			writeError(w, http.StatusBadRequest, errors.New("missing required query parameter 'q'"))
+			return
+		}
+
+		result, err := neo4j.ExecuteQuery(r.Context(), driver, `
+			MATCH (m:Movie)
+			WHERE m.title CONTAINS $q
+			RETURN m.title AS title
+			UNION
+			MATCH (p:Person)
+			WHERE p.name CONTAINS $q
+			RETURN p.name AS title
+		`,
+			map[string]any{"q": q},
+			neo4j.EagerResultTransformer,
+			neo4j.ExecuteQueryWithReadersRouting(),
+		)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		matches := make([]string, 0, len(result.Records))
+		for _, record := range result.Records {
+			title, _ := record.Get("title")
+			matches = append(matches, fmt.Sprint(title))
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{"query": q, "matches": matches})
+	}
+}
+
+func createMovieHandler(driver neo4j.DriverWithContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var input struct {
+			Title    string `json:"title"`
+			Released int64  `json:"released"`
+			Tagline  string `json:"tagline"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		if input.Title == "" {
+			writeError(w, http.StatusBadRequest, errors.New("title is required"))
+			return
+		}
+
+		_, err := neo4j.ExecuteQuery(r.Context(), driver,
+			"CREATE (m:Movie {title: $title, released: $released, tagline: $tagline})",
+			map[string]any{"title": input.Title, "released": input.Released, "tagline": input.Tagline},
+			neo4j.EagerResultTransformer,
+		)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, Movie{Title: input.Title, Released: input.Released, Tagline: input.Tagline})
+	}
+}
+
+func movieFromRecord(record *neo4j.Record) Movie {
+	titleVal, _ := record.Get("title")
+	releasedVal, _ := record.Get("released")
+	taglineVal, _ := record.Get("tagline")
+	castVal, _ := record.Get("cast")
+
+	released, _ := releasedVal.(int64)
+	tagline, _ := taglineVal.(string)
+
+	movie := Movie{Title: fmt.Sprint(titleVal), Released: released, Tagline: tagline}
+
+	if entries, ok := castVal.([]any); ok {
+		for _, e := range entries {
+			m, ok := e.(map[string]any)
+			if !ok {
+				continue
+			}
+			actor, _ := m["actor"].(string)
+			role, _ := m["role"].(string)
+			movie.Cast = append(movie.Cast, Role{Actor: actor, Role: role})
+		}
+	}
+	return movie
+}
+
+func stringsFrom(v any) []string {
+	raw, ok := v.([]any)
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(raw))
+	for _, e := range raw {
+		if s, ok := e.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,141 @@
+// Package spatialindex bootstraps Neo4j point indexes and answers
+// k-nearest-neighbour queries against them, replacing the O(N) city-by-city
+// scan the findingNearbyLocations example does today.
+package spatialindex
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// earthRadiusMeters is the mean Earth radius used by Neo4j's point.distance
+// for WGS-84 geographic points.
+const earthRadiusMeters = 6371008.8
+
+// EnsurePointIndex creates a POINT index on label.prop if one doesn't
+// already exist, so KNN's bounding-box prefilter can use it instead of a
+// full label scan.
+func EnsurePointIndex(ctx context.Context, driver neo4j.DriverWithContext, label, prop string) error {
+	name := fmt.Sprintf("%s_%s_point", strings.ToLower(label), strings.ToLower(prop))
+	cypher := fmt.Sprintf("CREATE POINT INDEX %s IF NOT EXISTS FOR (n:%s) ON (n.%s)", name, label, prop)
+
+	_, err := neo4j.ExecuteQuery(ctx, driver, cypher, nil, neo4j.EagerResultTransformer)
+	if err != nil {
+		return fmt.Errorf("spatialindex: create point index: %w", err)
+	}
+	return nil
+}
+
+// KNNQuery describes a k-nearest-neighbour search.
+type KNNQuery struct {
+	Label           string
+	PointProp       string
+	Center          neo4j.Point2D
+	K               int
+	MaxRadiusMeters float64
+}
+
+// Neighbor is one result of a KNN search.
+type Neighbor struct {
+	Node           neo4j.Node
+	DistanceMeters float64
+}
+
+// KNN finds up to q.K nodes of q.Label nearest to q.Center, ordered
+// closest-first. It starts the bounding-box prefilter at
+// q.MaxRadiusMeters/8 and doubles it until either K results are found or
+// MaxRadiusMeters is reached, so sparse regions don't pay for a
+// full-radius scan when a small one would do.
+func KNN(ctx context.Context, driver neo4j.DriverWithContext, q KNNQuery) ([]Neighbor, error) {
+	radius := q.MaxRadiusMeters / 8
+	if radius <= 0 || radius > q.MaxRadiusMeters {
+		radius = q.MaxRadiusMeters
+	}
+
+	for {
+		neighbors, err := knnWithinRadius(ctx, driver, q, radius)
+		if err != nil {
+			return nil, err
+		}
+		if len(neighbors) >= q.K || radius >= q.MaxRadiusMeters {
+			return neighbors, nil
+		}
+		radius = math.Min(radius*2, q.MaxRadiusMeters)
+	}
+}
+
+func knnWithinRadius(ctx context.Context, driver neo4j.DriverWithContext, q KNNQuery, radius float64) ([]Neighbor, error) {
+	minLat, minLon, maxLat, maxLon := boundingBox(q.Center, radius)
+
+	cypher := fmt.Sprintf(`
+		MATCH (n:%s)
+		WHERE point.withinBBox(
+			n.%s,
+			point({latitude: $minLat, longitude: $minLon}),
+			point({latitude: $maxLat, longitude: $maxLon})
+		)
+		WITH n, point.distance(n.%s, $center) AS distance
+		WHERE distance <= $radius
+		RETURN n, distance
+		ORDER BY distance ASC
+		LIMIT $k
+	`, q.Label, q.PointProp, q.PointProp)
+
+	result, err := neo4j.ExecuteQuery(ctx, driver,
+		cypher,
+		map[string]any{
+			"minLat": minLat,
+			"maxLat": maxLat,
+			"minLon": minLon,
+			"maxLon": maxLon,
+			"center": q.Center,
+			"radius": radius,
+			"k":      q.K,
+		},
+		neo4j.EagerResultTransformer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("spatialindex: knn query: %w", err)
+	}
+
+	neighbors := make([]Neighbor, 0, len(result.Records))
+	for _, record := range result.Records {
+		nodeVal, _ := record.Get("n")
+		distanceVal, _ := record.Get("distance")
+
+		node, ok := nodeVal.(neo4j.Node)
+		if !ok {
+			return nil, fmt.Errorf("spatialindex: expected neo4j.Node, got %T", nodeVal)
+		}
+		distance, ok := distanceVal.(float64)
+		if !ok {
+			return nil, fmt.Errorf("spatialindex: expected float64 distance, got %T", distanceVal)
+		}
+
+		neighbors = append(neighbors, Neighbor{Node: node, DistanceMeters: distance})
+	}
+	return neighbors, nil
+}
+
+// boundingBox computes a lat/lon envelope around center that contains every
+// point within radiusMeters, mirroring the prefilter geo.BoundingBox builds
+// for the plain MATCH-scan examples.
+func boundingBox(center neo4j.Point2D, radiusMeters float64) (minLat, minLon, maxLat, maxLon float64) {
+	lat := center.Y
+	lon := center.X
+
+	deltaLat := (radiusMeters / earthRadiusMeters) * (180 / math.Pi)
+	minLat = lat - deltaLat
+	maxLat = lat + deltaLat
+
+	latRad := math.Max(math.Abs(minLat), math.Abs(maxLat)) * math.Pi / 180
+	deltaLon := (radiusMeters / (earthRadiusMeters * math.Cos(latRad))) * (180 / math.Pi)
+	minLon = lon - deltaLon
+	maxLon = lon + deltaLon
+
+	return minLat, minLon, maxLat, maxLon
+}
@@ -0,0 +1,206 @@
+// Package temporal normalizes the six Neo4j temporal types - Date, LocalTime,
+// OffsetTime, LocalDateTime, zoned DateTime (already a plain time.Time in
+// this driver) and Duration - into time.Time, so callers don't have to
+// type-assert each one by hand the way the examples in temporal_spatial.go
+// do.
+package temporal
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// AsDate converts a neo4j.Date to a time.Time.
+func AsDate(v any) (time.Time, error) {
+	d, ok := v.(neo4j.Date)
+	if !ok {
+		return time.Time{}, fmt.Errorf("temporal: expected neo4j.Date, got %T", v)
+	}
+	return d.Time(), nil
+}
+
+// AsLocalTime converts a neo4j.LocalTime to a time.Time.
+func AsLocalTime(v any) (time.Time, error) {
+	t, ok := v.(neo4j.LocalTime)
+	if !ok {
+		return time.Time{}, fmt.Errorf("temporal: expected neo4j.LocalTime, got %T", v)
+	}
+	return t.Time(), nil
+}
+
+// AsOffsetTime converts a neo4j.OffsetTime (Cypher's zoned TIME) to a
+// time.Time.
+func AsOffsetTime(v any) (time.Time, error) {
+	t, ok := v.(neo4j.OffsetTime)
+	if !ok {
+		return time.Time{}, fmt.Errorf("temporal: expected neo4j.OffsetTime, got %T", v)
+	}
+	return t.Time(), nil
+}
+
+// AsLocalDateTime converts a neo4j.LocalDateTime to a time.Time.
+func AsLocalDateTime(v any) (time.Time, error) {
+	dt, ok := v.(neo4j.LocalDateTime)
+	if !ok {
+		return time.Time{}, fmt.Errorf("temporal: expected neo4j.LocalDateTime, got %T", v)
+	}
+	return dt.Time(), nil
+}
+
+// AsZonedDateTime converts Cypher's zoned DATETIME, which this driver
+// already represents as a plain time.Time, back out of an any.
+func AsZonedDateTime(v any) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("temporal: expected time.Time, got %T", v)
+	}
+	return t, nil
+}
+
+// AsTime converts any of this driver's temporal types to a time.Time,
+// asserting v against T first so call sites can pin down which column type
+// they expect, e.g. AsTime[neo4j.Date](raw).
+func AsTime[T any](v any) (time.Time, error) {
+	typed, ok := v.(T)
+	if !ok {
+		return time.Time{}, fmt.Errorf("temporal: expected %T, got %T", *new(T), v)
+	}
+	switch val := any(typed).(type) {
+	case neo4j.Date:
+		return val.Time(), nil
+	case neo4j.LocalTime:
+		return val.Time(), nil
+	case neo4j.OffsetTime:
+		return val.Time(), nil
+	case neo4j.LocalDateTime:
+		return val.Time(), nil
+	case time.Time:
+		return val, nil
+	default:
+		return time.Time{}, fmt.Errorf("temporal: unsupported type %T", v)
+	}
+}
+
+// Duration is neo4j.Duration decomposed into its four independent
+// components, mirroring the arguments to neo4j.DurationOf.
+type Duration struct {
+	Months  int64
+	Days    int64
+	Seconds int64
+	Nanos   int64
+}
+
+// DecomposeDuration pulls the four components out of a neo4j.Duration.
+func DecomposeDuration(d neo4j.Duration) Duration {
+	return Duration{
+		Months:  d.Months,
+		Days:    d.Days,
+		Seconds: d.Seconds,
+		Nanos:   int64(d.Nanos),
+	}
+}
+
+// AddTo adds d to t the way Cypher's `date() + duration(...)` does: months
+// are added first and clamped to the target month's last day (so Jan 31 +
+// P1M lands on Feb 28 or 29, not March 2/3 the way time.Time.AddDate would
+// roll it over), then days, then the remaining seconds and nanoseconds.
+func (d Duration) AddTo(t time.Time) time.Time {
+	t = addClampedMonths(t, d.Months)
+	t = t.AddDate(0, 0, int(d.Days))
+	return t.Add(time.Duration(d.Seconds)*time.Second + time.Duration(d.Nanos)*time.Nanosecond)
+}
+
+func addClampedMonths(t time.Time, months int64) time.Time {
+	if months == 0 {
+		return t
+	}
+
+	year, month, day := t.Date()
+	total := int64(month) - 1 + months
+	y := year + int(total/12)
+	m := int(total % 12)
+	if m < 0 {
+		m += 12
+		y--
+	}
+	targetMonth := time.Month(m + 1)
+
+	if last := lastDayOfMonth(y, targetMonth); day > last {
+		day = last
+	}
+	return time.Date(y, targetMonth, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+func lastDayOfMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// ScanRecord populates dst's exported fields from rec using `neo4j:"..."`
+// struct tags, converting any column tagged `type=datetime` through the
+// same temporal-type switch as AsTime. Fields without that tag option are
+// assigned directly, the way bind.ScanAll does for non-temporal columns.
+func ScanRecord(rec *neo4j.Record, dst any) error {
+	v := reflect.ValueOf(dst).Elem()
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		raw := field.Tag.Get("neo4j")
+		if raw == "" || raw == "-" {
+			continue
+		}
+
+		parts := strings.Split(raw, ",")
+		column := parts[0]
+		isDatetime := false
+		for _, opt := range parts[1:] {
+			if opt == "type=datetime" {
+				isDatetime = true
+			}
+		}
+
+		value, found := rec.Get(column)
+		if !found || value == nil {
+			continue
+		}
+
+		fv := v.Field(i)
+		if isDatetime {
+			converted, err := timeFromAny(value)
+			if err != nil {
+				return fmt.Errorf("column %q: %w", column, err)
+			}
+			fv.Set(reflect.ValueOf(converted))
+			continue
+		}
+
+		rv := reflect.ValueOf(value)
+		if !rv.Type().AssignableTo(fv.Type()) {
+			return fmt.Errorf("column %q: cannot assign %T to %s", column, value, fv.Type())
+		}
+		fv.Set(rv)
+	}
+
+	return nil
+}
+
+func timeFromAny(raw any) (time.Time, error) {
+	switch v := raw.(type) {
+	case neo4j.Date:
+		return v.Time(), nil
+	case neo4j.LocalTime:
+		return v.Time(), nil
+	case neo4j.OffsetTime:
+		return v.Time(), nil
+	case neo4j.LocalDateTime:
+		return v.Time(), nil
+	case time.Time:
+		return v, nil
+	default:
+		return time.Time{}, fmt.Errorf("temporal: unsupported type %T", raw)
+	}
+}
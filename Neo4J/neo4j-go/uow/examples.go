@@ -0,0 +1,58 @@
+package uow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// CreatePerson is best_practices.go's Example 4 (unitOfWorkPattern's
+// createPerson closure) ported to a single-step UnitOfWork, so its counters
+// come from UnitOfWorkResult instead of a manual result.Single(ctx) call.
+func CreatePerson(ctx context.Context, session neo4j.SessionWithContext, name string, age int64) (neo4j.Node, error) {
+	var person neo4j.Node
+
+	u := New().AddStep(Step{
+		Cypher: `CREATE (p:Person {name: $name, age: $age}) RETURN p`,
+		Params: map[string]any{"name": name, "age": age},
+		Bind: func(result neo4j.ResultWithContext) (any, error) {
+			record, err := result.Single(ctx)
+			if err != nil {
+				return nil, err
+			}
+			node, _ := record.Get("p")
+			person = node.(neo4j.Node)
+			return person, nil
+		},
+	})
+
+	if _, err := u.Run(ctx, session); err != nil {
+		return neo4j.Node{}, err
+	}
+	return person, nil
+}
+
+// TransferFunds is best_practices.go's Example 5 (multipleQueriesInTransaction's
+// transferFunds closure) ported to a two-step UnitOfWork. Either both steps
+// commit or neither does, the same atomicity the hand-rolled version got
+// from running both tx.Run calls inside one ExecuteWrite - here it also
+// comes back as consolidated PropertiesSet across both steps instead of two
+// untracked writes.
+func TransferFunds(ctx context.Context, session neo4j.SessionWithContext, fromAccount, toAccount string, amount float64) (UnitOfWorkResult, error) {
+	u := New().
+		AddStep(Step{
+			Cypher: `MATCH (a:Account {id: $from}) SET a.balance = a.balance - $amount`,
+			Params: map[string]any{"from": fromAccount, "amount": amount},
+		}).
+		AddStep(Step{
+			Cypher: `MATCH (a:Account {id: $to}) SET a.balance = a.balance + $amount`,
+			Params: map[string]any{"to": toAccount, "amount": amount},
+		})
+
+	result, err := u.Run(ctx, session)
+	if err != nil {
+		return UnitOfWorkResult{}, fmt.Errorf("uow: transfer funds: %w", err)
+	}
+	return result, nil
+}
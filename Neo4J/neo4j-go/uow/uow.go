@@ -0,0 +1,142 @@
+// Package uow composes a sequence of Cypher statements into a single
+// managed transaction, the way Example 4 and Example 5 in best_practices.go
+// do by hand with a closure per operation, but as a reusable type with
+// consolidated counters across every step.
+package uow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Step is one statement in a UnitOfWork.
+type Step struct {
+	Cypher string
+	Params map[string]any
+
+	// Bind, if set, receives the step's live result and returns a value
+	// later steps' predicates (and the caller, via UnitOfWorkResult.Steps)
+	// can use. Steps without a Bind func leave StepResult.Value nil.
+	Bind func(result neo4j.ResultWithContext) (any, error)
+}
+
+// StepResult is one step's outcome within a UnitOfWork.Run.
+type StepResult struct {
+	Value   any
+	Summary neo4j.ResultSummary
+}
+
+type conditionalStep struct {
+	step      Step
+	predicate func(prev StepResult) bool
+}
+
+// UnitOfWork is a sequence of steps that all run in one managed
+// transaction: any step's error rolls back every step that ran before it.
+type UnitOfWork struct {
+	steps []conditionalStep
+}
+
+// New returns an empty UnitOfWork.
+func New() *UnitOfWork {
+	return &UnitOfWork{}
+}
+
+// AddStep appends an unconditional step and returns u for chaining.
+func (u *UnitOfWork) AddStep(step Step) *UnitOfWork {
+	u.steps = append(u.steps, conditionalStep{step: step})
+	return u
+}
+
+// AddConditionalStep appends a step that only runs if predicate(prev)
+// returns true, where prev is the immediately preceding step's result (the
+// zero StepResult if this is the first step). A skipped step contributes
+// no StepResult and no counters.
+func (u *UnitOfWork) AddConditionalStep(step Step, predicate func(prev StepResult) bool) *UnitOfWork {
+	u.steps = append(u.steps, conditionalStep{step: step, predicate: predicate})
+	return u
+}
+
+// UnitOfWorkResult is the consolidated outcome of a UnitOfWork.Run: each
+// step's result, plus summary counters summed across every step that ran.
+type UnitOfWorkResult struct {
+	Steps []StepResult
+
+	NodesCreated         int
+	RelationshipsCreated int
+	PropertiesSet        int
+}
+
+// Run executes every step of u in a single write transaction on session,
+// stopping and rolling back at the first step error.
+func (u *UnitOfWork) Run(ctx context.Context, session neo4j.SessionWithContext) (UnitOfWorkResult, error) {
+	raw, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return runSteps(ctx, u.steps, func(ctx context.Context, i int, step Step) (StepResult, error) {
+			result, err := tx.Run(ctx, step.Cypher, step.Params)
+			if err != nil {
+				return StepResult{}, fmt.Errorf("uow: step %d: %w", i+1, err)
+			}
+
+			var value any
+			if step.Bind != nil {
+				value, err = step.Bind(result)
+				if err != nil {
+					return StepResult{}, fmt.Errorf("uow: step %d bind: %w", i+1, err)
+				}
+			}
+
+			summary, err := result.Consume(ctx)
+			if err != nil {
+				return StepResult{}, fmt.Errorf("uow: step %d consume: %w", i+1, err)
+			}
+
+			return StepResult{Value: value, Summary: summary}, nil
+		})
+	})
+	if err != nil {
+		return UnitOfWorkResult{}, err
+	}
+
+	out := UnitOfWorkResult{Steps: raw.([]StepResult)}
+	for _, step := range out.Steps {
+		counters := step.Summary.Counters()
+		out.NodesCreated += counters.NodesCreated()
+		out.RelationshipsCreated += counters.RelationshipsCreated()
+		out.PropertiesSet += counters.PropertiesSet()
+	}
+	return out, nil
+}
+
+// runSteps is the pure sequencing half of UnitOfWork.Run: it honors each
+// step's AddConditionalStep predicate against the previous step's result,
+// runs runStep for every step that isn't skipped, and stops at the first
+// error without running anything after it. It's pulled out of Run's
+// ExecuteWrite closure so uow_test.go can exercise give-up-after-step-N and
+// predicate-skip behavior against a fake runStep, rather than a live
+// neo4j.ManagedTransaction - which, like neo4j.ResultWithContext, has
+// unexported methods only the driver's own package can implement.
+func runSteps(ctx context.Context, steps []conditionalStep, runStep func(context.Context, int, Step) (StepResult, error)) ([]StepResult, error) {
+	var results []StepResult
+
+	for i, cs := range steps {
+		if cs.predicate != nil {
+			var prev StepResult
+			if len(results) > 0 {
+				prev = results[len(results)-1]
+			}
+			if !cs.predicate(prev) {
+				continue
+			}
+		}
+
+		result, err := runStep(ctx, i, cs.step)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,113 @@
+package uow
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRunStepsStopsAtFirstError(t *testing.T) {
+	u := New().
+		AddStep(Step{Cypher: "step 1"}).
+		AddStep(Step{Cypher: "step 2"}).
+		AddStep(Step{Cypher: "step 3"})
+
+	var ran []int
+	failAt := 1 // step 2 (0-indexed)
+	wantErr := errors.New("boom")
+
+	results, err := runSteps(context.Background(), u.steps, func(_ context.Context, i int, step Step) (StepResult, error) {
+		ran = append(ran, i)
+		if i == failAt {
+			return StepResult{}, wantErr
+		}
+		return StepResult{Value: step.Cypher}, nil
+	})
+
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("err = %v, want %v", err, wantErr)
+	}
+	if results != nil {
+		t.Errorf("results = %v, want nil (atomicity: no partial result set on error)", results)
+	}
+	if want := []int{0, 1}; !equalInts(ran, want) {
+		t.Errorf("runStep called for steps %v, want %v (step 3 must not run after step 2 fails)", ran, want)
+	}
+}
+
+func TestRunStepsRunsEveryStepOnSuccess(t *testing.T) {
+	u := New().
+		AddStep(Step{Cypher: "step 1"}).
+		AddStep(Step{Cypher: "step 2"})
+
+	results, err := runSteps(context.Background(), u.steps, func(_ context.Context, i int, step Step) (StepResult, error) {
+		return StepResult{Value: step.Cypher}, nil
+	})
+	if err != nil {
+		t.Fatalf("runSteps: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("len(results) = %d, want 2", len(results))
+	}
+	if results[0].Value != "step 1" || results[1].Value != "step 2" {
+		t.Errorf("results = %+v, want step 1 then step 2", results)
+	}
+}
+
+func TestRunStepsSkipsFailedPredicate(t *testing.T) {
+	u := New().
+		AddStep(Step{Cypher: "step 1"}).
+		AddConditionalStep(Step{Cypher: "step 2"}, func(prev StepResult) bool {
+			return prev.Value == "never"
+		})
+
+	var ran []int
+	results, err := runSteps(context.Background(), u.steps, func(_ context.Context, i int, step Step) (StepResult, error) {
+		ran = append(ran, i)
+		return StepResult{Value: step.Cypher}, nil
+	})
+	if err != nil {
+		t.Fatalf("runSteps: %v", err)
+	}
+	if want := []int{0}; !equalInts(ran, want) {
+		t.Errorf("runStep called for steps %v, want %v (step 2's predicate should skip it)", ran, want)
+	}
+	if len(results) != 1 {
+		t.Errorf("len(results) = %d, want 1 (skipped step contributes no StepResult)", len(results))
+	}
+}
+
+func TestRunStepsRunsConditionalStepWhenPredicatePasses(t *testing.T) {
+	u := New().
+		AddStep(Step{Cypher: "step 1"}).
+		AddConditionalStep(Step{Cypher: "step 2"}, func(prev StepResult) bool {
+			return prev.Value == "step 1"
+		})
+
+	var ran []int
+	results, err := runSteps(context.Background(), u.steps, func(_ context.Context, i int, step Step) (StepResult, error) {
+		ran = append(ran, i)
+		return StepResult{Value: step.Cypher}, nil
+	})
+	if err != nil {
+		t.Fatalf("runSteps: %v", err)
+	}
+	if want := []int{0, 1}; !equalInts(ran, want) {
+		t.Errorf("runStep called for steps %v, want %v", ran, want)
+	}
+	if len(results) != 2 {
+		t.Errorf("len(results) = %d, want 2", len(results))
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}